@@ -0,0 +1,154 @@
+package feedly
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+	"github.com/sfanous/go-feedly/internal/mapstructure"
+	"github.com/sfanous/go-feedly/pkg/time"
+)
+
+// AlertService provides methods for managing alerts, the keyword searches of the web whose results are collected into
+// a stream.
+type AlertService struct {
+	sling *sling.Sling
+}
+
+// newAlertService returns a new AlertService.
+func newAlertService(sling *sling.Sling) *AlertService {
+	return &AlertService{
+		sling: sling,
+	}
+}
+
+// Alert is a Feedly alert.
+type Alert struct {
+	Created *time.Time `json:"created,omitempty"`
+	ID      *string    `json:"id,omitempty"`
+	// Keywords are the keywords an entry must mention to be collected by the alert.
+	Keywords []string `json:"keywords,omitempty"`
+	Label    *string  `json:"label,omitempty"`
+	Language *string  `json:"language,omitempty"`
+	// StreamID is the ID of the stream collecting the entries matching the alert.
+	StreamID       *string                `json:"streamId,omitempty"`
+	Updated        *time.Time             `json:"updated,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// AlertCreateResponse represents the response from AlertService.Create.
+type AlertCreateResponse struct {
+	Alert          *Alert                 `json:"alert"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Create creates a new alert.
+func (s *AlertService) Create(alert *Alert) (*AlertCreateResponse, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), alert)
+}
+
+// CreateWithContext is like Create but uses ctx to control the lifetime of the request.
+func (s *AlertService) CreateWithContext(ctx context.Context, alert *Alert) (*AlertCreateResponse, *http.Response, error) {
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(AlertCreateResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("alerts").BodyJSON(alert), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Alert); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// Delete deletes an existing alert.
+func (s *AlertService) Delete(alertID string) (*http.Response, error) {
+	return s.DeleteWithContext(context.Background(), alertID)
+}
+
+// DeleteWithContext is like Delete but uses ctx to control the lifetime of the request.
+func (s *AlertService) DeleteWithContext(ctx context.Context, alertID string) (*http.Response, error) {
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Delete("alerts/"+url.PathEscape(alertID)), nil, apiError)
+
+	return resp, relevantError(resp, err, apiError)
+}
+
+// AlertListResponse represents the response from AlertService.List.
+type AlertListResponse struct {
+	Alerts         []Alert                `json:"alerts"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// List returns the list of alerts.
+func (s *AlertService) List() (*AlertListResponse, *http.Response, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but uses ctx to control the lifetime of the request.
+func (s *AlertService) ListWithContext(ctx context.Context) (*AlertListResponse, *http.Response, error) {
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(AlertListResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("alerts"), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Alerts); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// Stream returns the content of the stream of alert.
+func (s *AlertService) Stream(alert *Alert, optionalParams *StreamContentOptionalParams) (*StreamContentResponse, *http.Response, error) {
+	return s.StreamWithContext(context.Background(), alert, optionalParams)
+}
+
+// StreamWithContext is like Stream but uses ctx to control the lifetime of the request.
+func (s *AlertService) StreamWithContext(ctx context.Context, alert *Alert, optionalParams *StreamContentOptionalParams) (*StreamContentResponse, *http.Response, error) {
+	if alert.StreamID == nil {
+		return nil, nil, errors.New("feedly: alert has no stream ID")
+	}
+
+	return newStreamService(s.sling.New()).ContentWithContext(ctx, StreamID(*alert.StreamID), optionalParams)
+}
+
+// AlertUpdateResponse represents the response from AlertService.Update.
+type AlertUpdateResponse struct {
+	Alert          *Alert                 `json:"alert"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Update replaces the label, keywords, and language of an existing alert.
+func (s *AlertService) Update(alertID string, alert *Alert) (*AlertUpdateResponse, *http.Response, error) {
+	return s.UpdateWithContext(context.Background(), alertID, alert)
+}
+
+// UpdateWithContext is like Update but uses ctx to control the lifetime of the request.
+func (s *AlertService) UpdateWithContext(ctx context.Context, alertID string, alert *Alert) (*AlertUpdateResponse, *http.Response, error) {
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(AlertUpdateResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Put("alerts/"+url.PathEscape(alertID)).BodyJSON(alert), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Alert); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
@@ -0,0 +1,61 @@
+package feedly_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlerts(t *testing.T) {
+	server, _ := newTestServer(t, 0)
+	client := server.Client()
+
+	entryIDs := server.AddEntries(testFeedID,
+		feedly.Entry{Title: feedly.NewString("Acme launches a new product")},
+		feedly.Entry{Title: feedly.NewString("Globex acquires a startup")},
+	)
+
+	createResponse, _, err := client.Alerts.Create(&feedly.Alert{
+		Keywords: []string{"acme"},
+		Label:    feedly.NewString("Competitors"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, createResponse.Alert.StreamID)
+
+	_, _, err = client.Alerts.Create(&feedly.Alert{Label: feedly.NewString("Empty")})
+	assert.Error(t, err)
+
+	alert := createResponse.Alert
+
+	streamResponse, _, err := client.Alerts.Stream(alert, nil)
+	require.NoError(t, err)
+	require.Len(t, streamResponse.Stream.Items, 1)
+	assert.Equal(t, entryIDs[0], *streamResponse.Stream.Items[0].ID)
+	assert.Equal(t, "Competitors", *streamResponse.Stream.Title)
+
+	alert.Keywords = append(alert.Keywords, "globex")
+
+	updateResponse, _, err := client.Alerts.Update(*alert.ID, alert)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acme", "globex"}, updateResponse.Alert.Keywords)
+
+	streamResponse, _, err = client.Alerts.Stream(updateResponse.Alert, nil)
+	require.NoError(t, err)
+	assert.Len(t, streamResponse.Stream.Items, 2)
+
+	listResponse, _, err := client.Alerts.List()
+	require.NoError(t, err)
+	require.Len(t, listResponse.Alerts, 1)
+
+	_, err = client.Alerts.Delete(*alert.ID)
+	require.NoError(t, err)
+
+	_, _, err = client.Alerts.Stream(alert, nil)
+	assert.True(t, errors.Is(err, feedly.ErrNotFound))
+
+	_, _, err = client.Alerts.Stream(&feedly.Alert{}, nil)
+	assert.Error(t, err)
+}
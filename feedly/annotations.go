@@ -0,0 +1,230 @@
+package feedly
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+	"github.com/sfanous/go-feedly/internal/mapstructure"
+	"github.com/sfanous/go-feedly/pkg/time"
+)
+
+// AnnotationService provides methods for managing the highlights and notes of entries.
+type AnnotationService struct {
+	sling *sling.Sling
+}
+
+// newAnnotationService returns a new AnnotationService.
+func newAnnotationService(sling *sling.Sling) *AnnotationService {
+	return &AnnotationService{
+		sling: sling,
+	}
+}
+
+// Annotation is a Feedly annotation: a highlight of an entry, a note on an entry, or both.
+type Annotation struct {
+	AuthorID       *string                `json:"authorId,omitempty"`
+	Created        *time.Time             `json:"created,omitempty"`
+	EntryID        *string                `json:"entryId,omitempty"`
+	Highlight      *Highlight             `json:"highlight,omitempty"`
+	ID             *string                `json:"id,omitempty"`
+	Note           *Note                  `json:"note,omitempty"`
+	Updated        *time.Time             `json:"updated,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Highlight is a passage of an entry highlighted by a user.
+type Highlight struct {
+	Text           *string                `json:"text,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Note is a free-form note written by a user on an entry.
+type Note struct {
+	Text           *string                `json:"text,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// AnnotationCreateOptionalParams are the optional parameters for AnnotationService.Create.
+type AnnotationCreateOptionalParams struct {
+	Highlight *Highlight `json:"highlight,omitempty"`
+	Note      *Note      `json:"note,omitempty"`
+}
+
+// AnnotationCreateResponse represents the response from AnnotationService.Create.
+type AnnotationCreateResponse struct {
+	Annotation     *Annotation            `json:"annotation"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Create creates a new highlight, note, or both on an entry.
+func (s *AnnotationService) Create(entryID string, optionalParams *AnnotationCreateOptionalParams) (*AnnotationCreateResponse, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), entryID, optionalParams)
+}
+
+// CreateWithContext is like Create but uses ctx to control the lifetime of the request.
+func (s *AnnotationService) CreateWithContext(ctx context.Context, entryID string, optionalParams *AnnotationCreateOptionalParams) (*AnnotationCreateResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &AnnotationCreateOptionalParams{}
+	}
+
+	bodyJSON := &struct {
+		*AnnotationCreateOptionalParams
+		EntryID string `json:"entryId"`
+	}{
+		AnnotationCreateOptionalParams: optionalParams,
+		EntryID:                        entryID,
+	}
+
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(AnnotationCreateResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("annotations").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Annotation); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// Delete deletes an existing annotation.
+func (s *AnnotationService) Delete(annotationID string) (*http.Response, error) {
+	return s.DeleteWithContext(context.Background(), annotationID)
+}
+
+// DeleteWithContext is like Delete but uses ctx to control the lifetime of the request.
+func (s *AnnotationService) DeleteWithContext(ctx context.Context, annotationID string) (*http.Response, error) {
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Delete("annotations/"+url.PathEscape(annotationID)), nil, apiError)
+
+	return resp, relevantError(resp, err, apiError)
+}
+
+// AnnotationListByBoardOptionalParams are the optional parameters for AnnotationService.ListByBoard.
+type AnnotationListByBoardOptionalParams struct {
+	Continuation *string `url:"continuation,omitempty"`
+	Count        *int    `url:"count,omitempty"`
+}
+
+// AnnotationListByBoardResponse represents the response from AnnotationService.ListByBoard.
+type AnnotationListByBoardResponse struct {
+	Annotations    []Annotation           `json:"annotations,omitempty"`
+	Continuation   *string                `json:"continuation,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// ListByBoard returns the annotations of the entries of a board.
+func (s *AnnotationService) ListByBoard(boardID StreamID, optionalParams *AnnotationListByBoardOptionalParams) (*AnnotationListByBoardResponse, *http.Response, error) {
+	return s.ListByBoardWithContext(context.Background(), boardID, optionalParams)
+}
+
+// ListByBoardWithContext is like ListByBoard but uses ctx to control the lifetime of the request.
+func (s *AnnotationService) ListByBoardWithContext(ctx context.Context, boardID StreamID, optionalParams *AnnotationListByBoardOptionalParams) (*AnnotationListByBoardResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &AnnotationListByBoardOptionalParams{}
+	}
+
+	queryParams := &struct {
+		*AnnotationListByBoardOptionalParams
+		StreamID string `url:"streamId"`
+	}{
+		AnnotationListByBoardOptionalParams: optionalParams,
+		StreamID:                            boardID.String(),
+	}
+
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(AnnotationListByBoardResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("annotations").QueryStruct(queryParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, decodedResponse); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// AnnotationListByEntryResponse represents the response from AnnotationService.ListByEntry.
+type AnnotationListByEntryResponse struct {
+	Annotations    []Annotation           `json:"annotations,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// ListByEntry returns the annotations of an entry.
+func (s *AnnotationService) ListByEntry(entryID string) (*AnnotationListByEntryResponse, *http.Response, error) {
+	return s.ListByEntryWithContext(context.Background(), entryID)
+}
+
+// ListByEntryWithContext is like ListByEntry but uses ctx to control the lifetime of the request.
+func (s *AnnotationService) ListByEntryWithContext(ctx context.Context, entryID string) (*AnnotationListByEntryResponse, *http.Response, error) {
+	queryParams := &struct {
+		EntryID string `url:"entryId"`
+	}{
+		EntryID: entryID,
+	}
+
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(AnnotationListByEntryResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("annotations").QueryStruct(queryParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, decodedResponse); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// AnnotationUpdateOptionalParams are the optional parameters for AnnotationService.Update.
+type AnnotationUpdateOptionalParams struct {
+	Highlight *Highlight `json:"highlight,omitempty"`
+	Note      *Note      `json:"note,omitempty"`
+}
+
+// AnnotationUpdateResponse represents the response from AnnotationService.Update.
+type AnnotationUpdateResponse struct {
+	Annotation     *Annotation            `json:"annotation"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Update updates the highlight or the note of an existing annotation.
+func (s *AnnotationService) Update(annotationID string, optionalParams *AnnotationUpdateOptionalParams) (*AnnotationUpdateResponse, *http.Response, error) {
+	return s.UpdateWithContext(context.Background(), annotationID, optionalParams)
+}
+
+// UpdateWithContext is like Update but uses ctx to control the lifetime of the request.
+func (s *AnnotationService) UpdateWithContext(ctx context.Context, annotationID string, optionalParams *AnnotationUpdateOptionalParams) (*AnnotationUpdateResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &AnnotationUpdateOptionalParams{}
+	}
+
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(AnnotationUpdateResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Put("annotations/"+url.PathEscape(annotationID)).BodyJSON(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Annotation); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
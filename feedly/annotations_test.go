@@ -0,0 +1,67 @@
+package feedly_test
+
+import (
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnotations(t *testing.T) {
+	server, entryIDs := newTestServer(t, 2)
+	client := server.Client()
+
+	createResponse, _, err := client.Boards.Create("research", nil)
+	require.NoError(t, err)
+
+	boardID := *createResponse.Boards[0].ID
+
+	_, err = client.Boards.AddMultipleEntries([]string{boardID}, entryIDs)
+	require.NoError(t, err)
+
+	highlightResponse, _, err := client.Annotations.Create(entryIDs[0], &feedly.AnnotationCreateOptionalParams{
+		Highlight: &feedly.Highlight{Text: feedly.NewString("quote")},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, highlightResponse.Annotation)
+	assert.Equal(t, "quote", *highlightResponse.Annotation.Highlight.Text)
+
+	_, _, err = client.Annotations.Create(entryIDs[1], &feedly.AnnotationCreateOptionalParams{
+		Note: &feedly.Note{Text: feedly.NewString("remark")},
+	})
+	require.NoError(t, err)
+
+	annotationID := *highlightResponse.Annotation.ID
+
+	updateResponse, _, err := client.Annotations.Update(annotationID, &feedly.AnnotationUpdateOptionalParams{
+		Note: &feedly.Note{Text: feedly.NewString("why it matters")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "quote", *updateResponse.Annotation.Highlight.Text)
+	assert.Equal(t, "why it matters", *updateResponse.Annotation.Note.Text)
+
+	entryResponse, _, err := client.Annotations.ListByEntry(entryIDs[0])
+	require.NoError(t, err)
+	require.Len(t, entryResponse.Annotations, 1)
+	assert.Equal(t, annotationID, *entryResponse.Annotations[0].ID)
+
+	boardResponse, _, err := client.Annotations.ListByBoard(feedly.StreamID(boardID), &feedly.AnnotationListByBoardOptionalParams{
+		Count: feedly.NewInt(1),
+	})
+	require.NoError(t, err)
+	assert.Len(t, boardResponse.Annotations, 1)
+	assert.NotNil(t, boardResponse.Continuation)
+
+	contentResponse, _, err := client.Entries.Content(entryIDs[0])
+	require.NoError(t, err)
+	require.Len(t, contentResponse.Entries[0].Annotations, 1)
+	assert.Equal(t, "why it matters", *contentResponse.Entries[0].Annotations[0].Note.Text)
+
+	_, err = client.Annotations.Delete(annotationID)
+	require.NoError(t, err)
+
+	entryResponse, _, err = client.Annotations.ListByEntry(entryIDs[0])
+	require.NoError(t, err)
+	assert.Empty(t, entryResponse.Annotations)
+}
@@ -0,0 +1,118 @@
+// Package auth implements the Feedly OAuth2 authorization flow on top of golang.org/x/oauth2.
+//
+// A CLI tool obtains a token by sending the user to Feedly and receiving the authorization code on a loopback redirect
+// listener:
+//
+//	config := auth.NewConfig(clientID, clientSecret, "")
+//
+//	token, err := auth.AuthorizeLoopback(ctx, config, func(authURL string) error {
+//		fmt.Println("Visit", authURL)
+//
+//		return nil
+//	})
+//	if err != nil {
+//		...
+//	}
+//
+//	client := feedly.NewClient(config.Client(ctx, token))
+//
+// The http.Client returned by config.Client refreshes the access token as it expires, but the refreshed token is lost
+// when the program exits. A TokenSource saves it to a TokenStore instead, so the next run picks it up:
+//
+//	store := auth.NewFileTokenStore(filename)
+//
+//	client := feedly.NewClient(oauth2.NewClient(ctx, auth.NewTokenSource(ctx, config, store, nil)))
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"golang.org/x/oauth2"
+)
+
+// Scope is the OAuth2 scope granting access to the Feedly API.
+const Scope = "https://cloud.feedly.com/subscriptions"
+
+// Endpoint is the OAuth2 endpoint of the Feedly API.
+var Endpoint = NewEndpoint(feedly.APIBaseURL)
+
+// NewEndpoint returns the OAuth2 endpoint of the Feedly API served at apiBaseURL, such as the Feedly sandbox or a
+// feedlytest.Server.
+func NewEndpoint(apiBaseURL string) oauth2.Endpoint {
+	authBaseURL := strings.TrimSuffix(apiBaseURL, "/") + "/" + feedly.APIBaseVersion + "/auth/"
+
+	return oauth2.Endpoint{
+		AuthURL:   authBaseURL + "auth",
+		TokenURL:  authBaseURL + "token",
+		AuthStyle: oauth2.AuthStyleInParams,
+	}
+}
+
+// NewConfig returns an oauth2.Config for the Feedly API application identified by clientID and clientSecret.
+// redirectURL may be empty when the config is only used with AuthorizeLoopback, which then listens on a random port.
+func NewConfig(clientID string, clientSecret string, redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Endpoint:     Endpoint,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{Scope},
+	}
+}
+
+// Refresh exchanges the refresh token of token for a new access token, whether or not token has expired. The refresh
+// token of token is carried over, since Feedly does not issue a new one.
+func Refresh(ctx context.Context, config *oauth2.Config, token *oauth2.Token) (*oauth2.Token, error) {
+	return config.TokenSource(ctx, &oauth2.Token{RefreshToken: token.RefreshToken}).Token()
+}
+
+// UserID returns the ID of the Feedly user token was issued to, or an empty string if it is unknown.
+func UserID(token *oauth2.Token) string {
+	id, _ := token.Extra("id").(string)
+
+	return id
+}
+
+// Logout revokes token, along with its refresh token, by calling the logout endpoint next to the token endpoint of
+// config.
+func Logout(ctx context.Context, config *oauth2.Config, token *oauth2.Token) error {
+	logoutURL := strings.TrimSuffix(config.Endpoint.TokenURL, "/token") + "/logout"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, logoutURL, nil)
+	if err != nil {
+		return err
+	}
+
+	token.SetAuthHeader(req)
+
+	resp, err := httpClient(ctx).Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<10))
+
+		return fmt.Errorf("auth: logout failed: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+// httpClient returns the http.Client set in ctx under oauth2.HTTPClient, as used by golang.org/x/oauth2, or
+// http.DefaultClient.
+func httpClient(ctx context.Context) *http.Client {
+	if client, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok {
+		return client
+	}
+
+	return http.DefaultClient
+}
@@ -0,0 +1,164 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly/auth"
+	"github.com/sfanous/go-feedly/feedly/feedlytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func newTestConfig(server *feedlytest.Server, redirectURL string) *oauth2.Config {
+	config := auth.NewConfig("client", "secret", redirectURL)
+	config.Endpoint = auth.NewEndpoint(server.URL)
+
+	return config
+}
+
+// visit follows authURL as a browser would, through the fake authorization endpoint to the loopback listener.
+func visit(authURL string) error {
+	resp, err := http.Get(authURL)
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+func TestAuthorizeLoopback(t *testing.T) {
+	server := feedlytest.NewServer()
+	t.Cleanup(server.Close)
+
+	config := newTestConfig(server, "")
+	ctx := context.Background()
+
+	token, err := auth.AuthorizeLoopback(ctx, config, visit)
+	require.NoError(t, err)
+	assert.NotEmpty(t, token.AccessToken)
+	assert.NotEmpty(t, token.RefreshToken)
+	assert.True(t, token.Valid())
+	assert.Equal(t, server.UserID, auth.UserID(token))
+	assert.Empty(t, config.RedirectURL)
+
+	refreshed, err := auth.Refresh(ctx, config, token)
+	require.NoError(t, err)
+	assert.NotEqual(t, token.AccessToken, refreshed.AccessToken)
+	assert.Equal(t, token.RefreshToken, refreshed.RefreshToken)
+
+	require.NoError(t, auth.Logout(ctx, config, refreshed))
+
+	_, err = auth.Refresh(ctx, config, token)
+	assert.Error(t, err)
+
+	assert.Error(t, auth.Logout(ctx, config, token))
+}
+
+func TestAuthorizeLoopbackRedirectURL(t *testing.T) {
+	server := feedlytest.NewServer()
+	t.Cleanup(server.Close)
+
+	_, err := auth.AuthorizeLoopback(context.Background(), newTestConfig(server, "https://example.com/callback"), visit)
+	assert.Error(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = auth.AuthorizeLoopback(ctx, newTestConfig(server, ""), func(string) error {
+		return nil
+	})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestTokenSource(t *testing.T) {
+	server := feedlytest.NewServer()
+	t.Cleanup(server.Close)
+
+	config := newTestConfig(server, "")
+	ctx := context.Background()
+
+	token, err := auth.AuthorizeLoopback(ctx, config, visit)
+	require.NoError(t, err)
+	require.Equal(t, server.UserID, auth.UserID(token))
+
+	dir, err := ioutil.TempDir("", "auth")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "token.json")
+	store := auth.NewFileTokenStore(filename)
+
+	_, err = auth.NewTokenSource(ctx, config, store, nil).Token()
+	assert.True(t, errors.Is(err, auth.ErrNoToken))
+
+	token.Expiry = time.Now().Add(-time.Hour)
+	require.NoError(t, store.Save(token))
+
+	refreshed, err := auth.NewTokenSource(ctx, config, store, nil).Token()
+	require.NoError(t, err)
+	assert.NotEqual(t, token.AccessToken, refreshed.AccessToken)
+	assert.True(t, refreshed.Valid())
+
+	stored, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, refreshed.AccessToken, stored.AccessToken)
+	assert.Equal(t, token.RefreshToken, stored.RefreshToken)
+	assert.Equal(t, server.UserID, auth.UserID(stored))
+	assert.Equal(t, "standard", stored.Extra("plan"))
+
+	reloaded, err := auth.NewTokenSource(ctx, config, auth.NewFileTokenStore(filename), nil).Token()
+	require.NoError(t, err)
+	assert.Equal(t, refreshed.AccessToken, reloaded.AccessToken)
+	assert.Equal(t, server.UserID, auth.UserID(reloaded))
+}
+
+func TestTokenSourceDeveloperToken(t *testing.T) {
+	server := feedlytest.NewServer()
+	t.Cleanup(server.Close)
+
+	config := newTestConfig(server, "")
+	dir, err := ioutil.TempDir("", "auth")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	store := auth.NewFileTokenStore(filepath.Join(dir, "token.json"))
+
+	token := &oauth2.Token{
+		AccessToken: "developer",
+		Expiry:      time.Now().Add(time.Hour),
+	}
+	require.True(t, auth.IsDeveloperToken(token))
+	require.NoError(t, store.Save(token))
+
+	warnings := 0
+	tokenSource := auth.NewTokenSource(context.Background(), config, store, &auth.TokenSourceOptionalParams{
+		OnExpiryWarning: func(token *oauth2.Token, remaining time.Duration) {
+			warnings++
+
+			assert.True(t, remaining <= time.Hour)
+		},
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := tokenSource.Token()
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, warnings)
+
+	token.Expiry = time.Now().Add(-time.Hour)
+	require.NoError(t, store.Save(token))
+
+	_, err = auth.NewTokenSource(context.Background(), config, store, nil).Token()
+	assert.True(t, errors.Is(err, auth.ErrDeveloperTokenExpired))
+}
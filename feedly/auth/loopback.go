@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/oauth2"
+)
+
+// AuthorizeLoopback runs the authorization code flow of config for a CLI tool. It listens for the redirect on the
+// loopback interface, passes the authorization URL to openURL, which should open it in a browser or print it, and
+// exchanges the authorization code the browser is redirected with for a token.
+//
+// If config.RedirectURL is set, it must be an http URL of a loopback host, and AuthorizeLoopback listens on its port.
+// Otherwise it listens on a random port of 127.0.0.1. AuthorizeLoopback returns when a token is obtained, when the
+// user denies access, or when ctx is done.
+func AuthorizeLoopback(ctx context.Context, config *oauth2.Config, openURL func(authURL string) error) (*oauth2.Token, error) {
+	c := *config
+
+	listener, callbackPath, err := listenLoopback(&c)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := randomState()
+	if err != nil {
+		_ = listener.Close()
+
+		return nil, err
+	}
+
+	type result struct {
+		code string
+		err  error
+	}
+
+	results := make(chan result, 1)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Ignore requests the browser makes on its own, such as for a favicon.
+		if r.URL.Path != callbackPath {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		query := r.URL.Query()
+
+		var res result
+
+		switch {
+		case query.Get("state") != state:
+			res.err = errors.New("auth: redirect state mismatch")
+		case query.Get("error") != "":
+			res.err = fmt.Errorf("auth: authorization denied: %s", query.Get("error"))
+		case query.Get("code") == "":
+			res.err = errors.New("auth: redirect without authorization code")
+		default:
+			res.code = query.Get("code")
+		}
+
+		if res.err != nil {
+			http.Error(w, res.err.Error(), http.StatusBadRequest)
+		} else {
+			_, _ = fmt.Fprintln(w, "Authorization complete, you may close this window.")
+		}
+
+		select {
+		case results <- res:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: handler}
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	defer server.Close()
+
+	if err := openURL(c.AuthCodeURL(state)); err != nil {
+		return nil, err
+	}
+
+	select {
+	case res := <-results:
+		if res.err != nil {
+			return nil, res.err
+		}
+
+		return c.Exchange(ctx, res.code)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// listenLoopback listens on the loopback address of config.RedirectURL, setting it to a random port of 127.0.0.1 if it
+// is empty, and returns the listener along with the path of the redirect.
+func listenLoopback(config *oauth2.Config) (net.Listener, string, error) {
+	if config.RedirectURL == "" {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return nil, "", err
+		}
+
+		config.RedirectURL = "http://" + listener.Addr().String() + "/"
+
+		return listener, "/", nil
+	}
+
+	u, err := url.Parse(config.RedirectURL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if u.Scheme != "http" || !isLoopback(u.Hostname()) {
+		return nil, "", fmt.Errorf("auth: redirect URL %q is not an http loopback URL", config.RedirectURL)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "80"
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		return nil, "", err
+	}
+
+	path := u.Path
+	if path == "" {
+		path = "/"
+	}
+
+	return listener, path, nil
+}
+
+// isLoopback reports whether host is localhost or a loopback IP address.
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// randomState returns a random value for the state parameter of an authorization request.
+func randomState() (string, error) {
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
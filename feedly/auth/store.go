@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenExtras are the fields of the Feedly token response, beyond those of oauth2.Token, kept by FileTokenStore.
+var tokenExtras = []string{"id", "plan", "provider", "scope", "state"}
+
+// storedToken is the format of the file of a FileTokenStore: an oauth2.Token along with its extra fields, which
+// oauth2.Token does not marshal.
+type storedToken struct {
+	*oauth2.Token
+	Extra map[string]interface{} `json:"extra,omitempty"`
+}
+
+// TokenStore persists a token between runs.
+type TokenStore interface {
+	// Load returns the stored token, or nil if there is none.
+	Load() (*oauth2.Token, error)
+	// Save replaces the stored token with token.
+	Save(token *oauth2.Token) error
+}
+
+// Locker is implemented by a TokenStore shared between processes, so that only one of them refreshes an expired token
+// at a time.
+type Locker interface {
+	// Lock blocks until the store is locked, and returns a function unlocking it.
+	Lock() (unlock func() error, err error)
+}
+
+// FileTokenStore is a TokenStore keeping a token in a JSON file, in the format of oauth2.Token along with an "extra"
+// object holding the extra fields of the token, such as the user ID read by UserID. It implements Locker
+// with an advisory lock on a sibling file on platforms supporting flock, and is a no-op elsewhere.
+//
+// On platforms without flock, such as Windows, a FileTokenStore is not safe to share across processes: two processes
+// may refresh the token at once, and the refresh token saved by one may be overwritten by the other, already revoked.
+// Use a single process per file there, or a TokenStore implementing Locker with a lock of its own.
+type FileTokenStore struct {
+	filename string
+}
+
+// NewFileTokenStore returns a FileTokenStore keeping a token in the file filename.
+func NewFileTokenStore(filename string) *FileTokenStore {
+	return &FileTokenStore{
+		filename: filename,
+	}
+}
+
+// Load implements the TokenStore interface.
+func (s *FileTokenStore) Load() (*oauth2.Token, error) {
+	b, err := ioutil.ReadFile(s.filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	stored := storedToken{
+		Token: &oauth2.Token{},
+	}
+
+	if err := json.Unmarshal(b, &stored); err != nil {
+		return nil, err
+	}
+
+	if len(stored.Extra) > 0 {
+		return stored.Token.WithExtra(stored.Extra), nil
+	}
+
+	return stored.Token, nil
+}
+
+// Save implements the TokenStore interface. The token is written to a temporary file renamed over the file of the
+// store, so readers never observe a partially written token.
+func (s *FileTokenStore) Save(token *oauth2.Token) error {
+	stored := storedToken{
+		Token: token,
+		Extra: make(map[string]interface{}),
+	}
+
+	for _, key := range tokenExtras {
+		if value := token.Extra(key); value != nil {
+			stored.Extra[key] = value
+		}
+	}
+
+	b, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(s.filename), "."+filepath.Base(s.filename)+".*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), s.filename)
+}
+
+// Lock implements the Locker interface.
+func (s *FileTokenStore) Lock() (func() error, error) {
+	f, err := os.OpenFile(s.filename+".lock", os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lockFile(f); err != nil {
+		_ = f.Close()
+
+		return nil, err
+	}
+
+	return func() error {
+		err := unlockFile(f)
+
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+
+		return err
+	}, nil
+}
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// DefaultExpiryWarning is how long before it expires a token that cannot be refreshed is warned about by default.
+const DefaultExpiryWarning = 72 * time.Hour
+
+var (
+	// ErrNoToken is returned by TokenSource.Token when its TokenStore holds no token.
+	ErrNoToken = errors.New("auth: no token stored")
+	// ErrDeveloperTokenExpired is returned by TokenSource.Token when a developer token, which cannot be refreshed, has
+	// expired. A new developer token must be generated.
+	ErrDeveloperTokenExpired = errors.New("auth: developer token expired")
+)
+
+// IsDeveloperToken reports whether token is a Feedly developer token, which comes without a refresh token and cannot
+// be refreshed.
+func IsDeveloperToken(token *oauth2.Token) bool {
+	return token.RefreshToken == ""
+}
+
+// TokenSourceOptionalParams are the optional parameters for NewTokenSource.
+type TokenSourceOptionalParams struct {
+	// ExpiryWarning is how long before it expires a developer token is warned about. Defaults to
+	// DefaultExpiryWarning.
+	ExpiryWarning *time.Duration
+	// OnExpiryWarning is called, once per token, when a developer token is about to expire. Defaults to logging a
+	// message with the log package.
+	OnExpiryWarning func(token *oauth2.Token, remaining time.Duration)
+}
+
+// TokenSource is an oauth2.TokenSource reading its token from a TokenStore, refreshing it with an oauth2.Config as it
+// expires, and saving the refreshed token back to the store. When the store implements Locker, it is locked during a
+// refresh, and a token refreshed meanwhile by another process is picked up instead of being refreshed again.
+type TokenSource struct {
+	ctx             context.Context
+	config          *oauth2.Config
+	store           TokenStore
+	expiryWarning   time.Duration
+	onExpiryWarning func(token *oauth2.Token, remaining time.Duration)
+
+	mu     sync.Mutex
+	token  *oauth2.Token
+	warned string
+}
+
+// NewTokenSource returns a TokenSource refreshing the token of store with config. ctx is used by the refresh requests.
+func NewTokenSource(ctx context.Context, config *oauth2.Config, store TokenStore, optionalParams *TokenSourceOptionalParams) *TokenSource {
+	if optionalParams == nil {
+		optionalParams = &TokenSourceOptionalParams{}
+	}
+
+	s := &TokenSource{
+		ctx:             ctx,
+		config:          config,
+		store:           store,
+		expiryWarning:   DefaultExpiryWarning,
+		onExpiryWarning: optionalParams.OnExpiryWarning,
+	}
+
+	if optionalParams.ExpiryWarning != nil {
+		s.expiryWarning = *optionalParams.ExpiryWarning
+	}
+
+	if s.onExpiryWarning == nil {
+		s.onExpiryWarning = func(token *oauth2.Token, remaining time.Duration) {
+			log.Printf("auth: developer token expires in %s, generate a new one", remaining.Round(time.Minute))
+		}
+	}
+
+	return s
+}
+
+// Token implements the oauth2.TokenSource interface.
+func (s *TokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		token, err := s.store.Load()
+		if err != nil {
+			return nil, err
+		}
+
+		if token == nil {
+			return nil, ErrNoToken
+		}
+
+		s.token = token
+	}
+
+	if !s.token.Valid() {
+		if err := s.refresh(); err != nil {
+			return nil, err
+		}
+	}
+
+	s.warn()
+
+	token := *s.token
+
+	return &token, nil
+}
+
+// refresh replaces the expired token with a valid one, either saved by another user of the store or refreshed and
+// then saved.
+func (s *TokenSource) refresh() (err error) {
+	if IsDeveloperToken(s.token) {
+		return ErrDeveloperTokenExpired
+	}
+
+	if locker, ok := s.store.(Locker); ok {
+		unlock, err := locker.Lock()
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			if unlockErr := unlock(); err == nil {
+				err = unlockErr
+			}
+		}()
+	}
+
+	stored, err := s.store.Load()
+	if err != nil {
+		return err
+	}
+
+	if stored != nil && stored.Valid() {
+		s.token = stored
+
+		return nil
+	}
+
+	refreshed, err := s.config.TokenSource(s.ctx, s.token).Token()
+	if err != nil {
+		return err
+	}
+
+	if err := s.store.Save(refreshed); err != nil {
+		return err
+	}
+
+	s.token = refreshed
+
+	return nil
+}
+
+// warn calls onExpiryWarning if the token is a developer token expiring within expiryWarning, unless it has already
+// been warned about.
+func (s *TokenSource) warn() {
+	if !IsDeveloperToken(s.token) || s.token.Expiry.IsZero() || s.warned == s.token.AccessToken {
+		return
+	}
+
+	remaining := time.Until(s.token.Expiry)
+	if remaining > s.expiryWarning {
+		return
+	}
+
+	s.warned = s.token.AccessToken
+	s.onExpiryWarning(s.token, remaining)
+}
@@ -1,10 +1,10 @@
 package feedly
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/url"
-	"strings"
 
 	"github.com/dghubble/sling"
 	"github.com/sfanous/go-feedly/internal/mapstructure"
@@ -26,6 +26,7 @@ func newBoardService(sling *sling.Sling) *BoardService {
 
 // Board is a Feedly board.
 type Board struct {
+	ACL            []ACLEntry             `json:"acl,omitempty"`
 	Cover          *string                `json:"cover,omitempty"`
 	Created        *time.Time             `json:"created,omitempty"`
 	Customizable   *bool                  `json:"customizable,omitempty"`
@@ -43,6 +44,11 @@ type Board struct {
 
 // AddEntry adds one entry to one or more existing boards.
 func (s *BoardService) AddEntry(BoardIDs []string, entryID string) (*http.Response, error) {
+	return s.AddEntryWithContext(context.Background(), BoardIDs, entryID)
+}
+
+// AddEntryWithContext is like AddEntry but uses ctx to control the lifetime of the request.
+func (s *BoardService) AddEntryWithContext(ctx context.Context, BoardIDs []string, entryID string) (*http.Response, error) {
 	bodyJSON := &struct {
 		EntryID string `json:"entryId,omitempty"`
 	}{
@@ -51,13 +57,18 @@ func (s *BoardService) AddEntry(BoardIDs []string, entryID string) (*http.Respon
 
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Put("tags/"+url.PathEscape(strings.Join(BoardIDs, ","))).BodyJSON(bodyJSON).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Put("tags/"+pathEscapeIDs(BoardIDs)).BodyJSON(bodyJSON), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // AddMultipleEntries adds one or more entries to one or more existing boards.
 func (s *BoardService) AddMultipleEntries(BoardIDs []string, entryIDs []string) (*http.Response, error) {
+	return s.AddMultipleEntriesWithContext(context.Background(), BoardIDs, entryIDs)
+}
+
+// AddMultipleEntriesWithContext is like AddMultipleEntries but uses ctx to control the lifetime of the request.
+func (s *BoardService) AddMultipleEntriesWithContext(ctx context.Context, BoardIDs []string, entryIDs []string) (*http.Response, error) {
 	bodyJSON := &struct {
 		EntryIds []string `json:"entryIds,omitempty"`
 	}{
@@ -66,9 +77,9 @@ func (s *BoardService) AddMultipleEntries(BoardIDs []string, entryIDs []string)
 
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Put("tags/"+url.PathEscape(strings.Join(BoardIDs, ","))).BodyJSON(bodyJSON).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Put("tags/"+pathEscapeIDs(BoardIDs)).BodyJSON(bodyJSON), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // BoardCreateOptionalParams are the optional parameters for BoardService.Create.
@@ -88,6 +99,11 @@ type BoardCreateResponse struct {
 
 // Create creates a new board.
 func (s *BoardService) Create(label string, optionalParams *BoardCreateOptionalParams) (*BoardCreateResponse, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), label, optionalParams)
+}
+
+// CreateWithContext is like Create but uses ctx to control the lifetime of the request.
+func (s *BoardService) CreateWithContext(ctx context.Context, label string, optionalParams *BoardCreateOptionalParams) (*BoardCreateResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &BoardCreateOptionalParams{}
 	}
@@ -104,8 +120,8 @@ func (s *BoardService) Create(label string, optionalParams *BoardCreateOptionalP
 	decodedResponse := new(BoardCreateResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("boards").BodyJSON(bodyJSON).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Post("boards").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -118,15 +134,25 @@ func (s *BoardService) Create(label string, optionalParams *BoardCreateOptionalP
 
 // Delete deletes one or more existing boards.
 func (s *BoardService) Delete(boardIDs []string) (*http.Response, error) {
+	return s.DeleteWithContext(context.Background(), boardIDs)
+}
+
+// DeleteWithContext is like Delete but uses ctx to control the lifetime of the request.
+func (s *BoardService) DeleteWithContext(ctx context.Context, boardIDs []string) (*http.Response, error) {
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Delete("tags/"+url.PathEscape(strings.Join(boardIDs, ","))).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Delete("tags/"+pathEscapeIDs(boardIDs)), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // DeleteEntry deletes one entry from one or more existing boards.
 func (s *BoardService) DeleteEntry(BoardIDs []string, entryID string) (*http.Response, error) {
+	return s.DeleteEntryWithContext(context.Background(), BoardIDs, entryID)
+}
+
+// DeleteEntryWithContext is like DeleteEntry but uses ctx to control the lifetime of the request.
+func (s *BoardService) DeleteEntryWithContext(ctx context.Context, BoardIDs []string, entryID string) (*http.Response, error) {
 	bodyJSON := &struct {
 		EntryID string `json:"entryId,omitempty"`
 	}{
@@ -135,13 +161,18 @@ func (s *BoardService) DeleteEntry(BoardIDs []string, entryID string) (*http.Res
 
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Delete("tags/"+url.PathEscape(strings.Join(BoardIDs, ","))).BodyJSON(bodyJSON).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Delete("tags/"+pathEscapeIDs(BoardIDs)).BodyJSON(bodyJSON), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // DeleteMultipleEntries deletes one or more entries from one or more existing boards.
 func (s *BoardService) DeleteMultipleEntries(BoardIDs []string, entryIDs []string) (*http.Response, error) {
+	return s.DeleteMultipleEntriesWithContext(context.Background(), BoardIDs, entryIDs)
+}
+
+// DeleteMultipleEntriesWithContext is like DeleteMultipleEntries but uses ctx to control the lifetime of the request.
+func (s *BoardService) DeleteMultipleEntriesWithContext(ctx context.Context, BoardIDs []string, entryIDs []string) (*http.Response, error) {
 	bodyJSON := &struct {
 		EntryIds []string `json:"entryIds,omitempty"`
 	}{
@@ -150,9 +181,9 @@ func (s *BoardService) DeleteMultipleEntries(BoardIDs []string, entryIDs []strin
 
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Delete("tags/"+url.PathEscape(strings.Join(BoardIDs, ","))).BodyJSON(bodyJSON).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Delete("tags/"+pathEscapeIDs(BoardIDs)).BodyJSON(bodyJSON), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // BoardDetailResponse represents the response from BoardService.Details.
@@ -162,13 +193,18 @@ type BoardDetailResponse struct {
 }
 
 // Details returns details about a board.
-func (s *BoardService) Details(boardID string) (*BoardDetailResponse, *http.Response, error) {
+func (s *BoardService) Details(boardID StreamID) (*BoardDetailResponse, *http.Response, error) {
+	return s.DetailsWithContext(context.Background(), boardID)
+}
+
+// DetailsWithContext is like Details but uses ctx to control the lifetime of the request.
+func (s *BoardService) DetailsWithContext(ctx context.Context, boardID StreamID) (*BoardDetailResponse, *http.Response, error) {
 	encodedResponse := make([]map[string]interface{}, 0)
 	decodedResponse := new(BoardDetailResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("boards/"+url.PathEscape(boardID)).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("boards/"+url.PathEscape(boardID.String())), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -192,6 +228,11 @@ type BoardListResponse struct {
 
 // List returns the list of boards.
 func (s *BoardService) List(optionalParams *BoardListOptionalParams) (*BoardListResponse, *http.Response, error) {
+	return s.ListWithContext(context.Background(), optionalParams)
+}
+
+// ListWithContext is like List but uses ctx to control the lifetime of the request.
+func (s *BoardService) ListWithContext(ctx context.Context, optionalParams *BoardListOptionalParams) (*BoardListResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &BoardListOptionalParams{}
 	}
@@ -200,8 +241,8 @@ func (s *BoardService) List(optionalParams *BoardListOptionalParams) (*BoardList
 	decodedResponse := new(BoardListResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("boards").QueryStruct(optionalParams).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("boards").QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -229,7 +270,12 @@ type BoardUpdateResponse struct {
 }
 
 // Update updates an existing board.
-func (s *BoardService) Update(boardID string, optionalParams *BoardUpdateOptionalParams) (*BoardUpdateResponse, *http.Response, error) {
+func (s *BoardService) Update(boardID StreamID, optionalParams *BoardUpdateOptionalParams) (*BoardUpdateResponse, *http.Response, error) {
+	return s.UpdateWithContext(context.Background(), boardID, optionalParams)
+}
+
+// UpdateWithContext is like Update but uses ctx to control the lifetime of the request.
+func (s *BoardService) UpdateWithContext(ctx context.Context, boardID StreamID, optionalParams *BoardUpdateOptionalParams) (*BoardUpdateResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &BoardUpdateOptionalParams{}
 	}
@@ -239,15 +285,15 @@ func (s *BoardService) Update(boardID string, optionalParams *BoardUpdateOptiona
 		ID string `json:"id"`
 	}{
 		BoardUpdateOptionalParams: optionalParams,
-		ID:                        boardID,
+		ID:                        boardID.String(),
 	}
 
 	encodedResponse := make([]map[string]interface{}, 0)
 	decodedResponse := new(BoardUpdateResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("boards").BodyJSON(bodyJSON).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Post("boards").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -265,7 +311,12 @@ type BoardUploadCoverImageResponse struct {
 }
 
 // UploadCoverImage uploads a new cover image for an existing board.
-func (s *BoardService) UploadCoverImage(boardID string, coverImage io.Reader) (*BoardUploadCoverImageResponse, *http.Response, error) {
+func (s *BoardService) UploadCoverImage(boardID StreamID, coverImage io.Reader) (*BoardUploadCoverImageResponse, *http.Response, error) {
+	return s.UploadCoverImageWithContext(context.Background(), boardID, coverImage)
+}
+
+// UploadCoverImageWithContext is like UploadCoverImage but uses ctx to control the lifetime of the request.
+func (s *BoardService) UploadCoverImageWithContext(ctx context.Context, boardID StreamID, coverImage io.Reader) (*BoardUploadCoverImageResponse, *http.Response, error) {
 	body, contentType, err := mime.CreateMultipartMIMEAttachment(coverImage)
 	if err != nil {
 		return nil, nil, err
@@ -275,8 +326,8 @@ func (s *BoardService) UploadCoverImage(boardID string, coverImage io.Reader) (*
 	decodedResponse := new(BoardUploadCoverImageResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("boards/"+url.PathEscape(boardID)).Body(body).Set("Content-Type", contentType).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Post("boards/"+url.PathEscape(boardID.String())).Body(body).Set("Content-Type", contentType), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
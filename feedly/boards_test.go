@@ -107,7 +107,7 @@ func testBoardServiceDeleteMultipleEntries(t *testing.T, boardIDs []string, entr
 }
 
 func testBoardsServiceDetails(t *testing.T, board *feedly.Board) {
-	detailResponse, resp, err := client.Boards.Details(*board.ID)
+	detailResponse, resp, err := client.Boards.Details(feedly.StreamID(*board.ID))
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -171,7 +171,7 @@ func testBoardServiceList(t *testing.T) {
 }
 
 func testBoardServiceUpdate(t *testing.T, board *feedly.Board) {
-	updateResponse, resp, err := client.Boards.Update(*board.ID, &feedly.BoardUpdateOptionalParams{
+	updateResponse, resp, err := client.Boards.Update(feedly.StreamID(*board.ID), &feedly.BoardUpdateOptionalParams{
 		DeleteCover: feedly.NewBool(true),
 		Description: feedly.NewString(*board.Label + " updated by go-feedly for testing"),
 		Label:       feedly.NewString(strings.ToUpper(*board.Label)),
@@ -216,7 +216,7 @@ func testBoardServiceUploadCoverImage(t *testing.T, board *feedly.Board) {
 		}
 	}()
 
-	uploadCoverImageResponse, resp, err := client.Boards.UploadCoverImage(*board.ID, coverImage)
+	uploadCoverImageResponse, resp, err := client.Boards.UploadCoverImage(feedly.StreamID(*board.ID), coverImage)
 	if err != nil {
 		t.Errorf("%v", err)
 	}
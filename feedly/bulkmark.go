@@ -0,0 +1,223 @@
+package feedly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sfanous/go-feedly/pkg/time"
+)
+
+// MarkerBulkMarkChunkSize is the default maximum number of IDs marked at once by MarkerService.BulkMark.
+const MarkerBulkMarkChunkSize = 1000
+
+// ErrUnsupportedMark is returned by MarkerService.BulkMark for an action that does not apply to a type.
+var ErrUnsupportedMark = errors.New("feedly: unsupported mark")
+
+// markActions are the actions supported by each type, and their inverse action, if any.
+var markActions = map[MarkType]map[MarkAction]MarkAction{
+	Collections: {
+		MarkAsRead:     UndoMarkAsRead,
+		UndoMarkAsRead: "",
+	},
+	Entries: {
+		KeepUnread:    MarkAsRead,
+		MarkAsRead:    KeepUnread,
+		MarkAsSaved:   MarkAsUnsaved,
+		MarkAsUnsaved: MarkAsSaved,
+	},
+	Feeds: {
+		MarkAsRead:     UndoMarkAsRead,
+		UndoMarkAsRead: "",
+	},
+	Tags: {
+		MarkAsRead: "",
+	},
+}
+
+// MarkOperation is a mark operation sent by MarkerService.BulkMark.
+type MarkOperation struct {
+	Action          MarkAction
+	AsOf            *time.Time
+	IDs             []string
+	LastReadEntryID *string
+	Type            MarkType
+}
+
+// MarkerBulkMarkOptionalParams are the optional parameters for MarkerService.BulkMark.
+type MarkerBulkMarkOptionalParams struct {
+	// AsOf only marks the entries crawled before it as read. It applies to MarkAsRead on collections, feeds, and tags.
+	AsOf *time.Time
+	// ChunkSize is the maximum number of IDs marked at once. Defaults to MarkerBulkMarkChunkSize.
+	ChunkSize *int
+	// LastReadEntryID only marks the entries older than it as read. It applies to MarkAsRead on collections, feeds, and
+	// tags.
+	LastReadEntryID *string
+	// OnProgress, if not nil, is called after each chunk with the number of IDs marked so far and the total number of IDs.
+	OnProgress func(marked int, total int)
+}
+
+// MarkerBulkMarkResponse represents the response from MarkerService.BulkMark.
+type MarkerBulkMarkResponse struct {
+	// Applied are the operations sent, one per chunk.
+	Applied []MarkOperation
+	// Undo are the operations reverting Applied, to be passed to MarkerService.Undo. It is empty if the action cannot be
+	// undone: UndoMarkAsRead, and MarkAsRead on tags. The undo operations carry no AsOf or LastReadEntryID, which Feedly
+	// does not accept with UndoMarkAsRead: undoing restores the entries marked as read by the operation being undone.
+	Undo []MarkOperation
+}
+
+// BulkMark applies markAction to the collections, entries, feeds, or tags ids, depending on markType, in chunks. An
+// error wrapping ErrUnsupportedMark is returned, before any request is sent, if markAction does not apply to markType.
+// If a chunk fails, the response lists the operations applied before the failure along with the error.
+//
+// Undoing marking entries as read, resp. saved, marks them all as unread, resp. unsaved, regardless of their state
+// before BulkMark.
+func (s *MarkerService) BulkMark(markAction MarkAction, markType MarkType, ids []string, optionalParams *MarkerBulkMarkOptionalParams) (*MarkerBulkMarkResponse, *http.Response, error) {
+	return s.BulkMarkWithContext(context.Background(), markAction, markType, ids, optionalParams)
+}
+
+// BulkMarkWithContext is like BulkMark but uses ctx to control the lifetime of the requests.
+func (s *MarkerService) BulkMarkWithContext(ctx context.Context, markAction MarkAction, markType MarkType, ids []string, optionalParams *MarkerBulkMarkOptionalParams) (*MarkerBulkMarkResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &MarkerBulkMarkOptionalParams{}
+	}
+
+	inverseAction, err := inverseMarkAction(markAction, markType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if markType == Entries && (optionalParams.AsOf != nil || optionalParams.LastReadEntryID != nil) {
+		return nil, nil, fmt.Errorf("%w: AsOf and LastReadEntryID do not apply to %s", ErrUnsupportedMark, markType)
+	}
+
+	if markAction != MarkAsRead && (optionalParams.AsOf != nil || optionalParams.LastReadEntryID != nil) {
+		return nil, nil, fmt.Errorf("%w: AsOf and LastReadEntryID do not apply to %s", ErrUnsupportedMark, markAction)
+	}
+
+	chunkSize := MarkerBulkMarkChunkSize
+	if optionalParams.ChunkSize != nil && *optionalParams.ChunkSize > 0 {
+		chunkSize = *optionalParams.ChunkSize
+	}
+
+	decodedResponse := &MarkerBulkMarkResponse{
+		Applied: make([]MarkOperation, 0),
+		Undo:    make([]MarkOperation, 0),
+	}
+
+	var resp *http.Response
+
+	for start := 0; start < len(ids); start += chunkSize {
+		end := start + chunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		operation := MarkOperation{
+			Action:          markAction,
+			AsOf:            optionalParams.AsOf,
+			IDs:             ids[start:end],
+			LastReadEntryID: optionalParams.LastReadEntryID,
+			Type:            markType,
+		}
+
+		resp, err = s.apply(ctx, operation)
+		if err != nil {
+			return decodedResponse, resp, err
+		}
+
+		decodedResponse.Applied = append(decodedResponse.Applied, operation)
+
+		if inverseAction != "" {
+			decodedResponse.Undo = append([]MarkOperation{{
+				Action: inverseAction,
+				IDs:    operation.IDs,
+				Type:   markType,
+			}}, decodedResponse.Undo...)
+		}
+
+		if optionalParams.OnProgress != nil {
+			optionalParams.OnProgress(end, len(ids))
+		}
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// Undo sends operations in order, typically MarkerBulkMarkResponse.Undo, and returns the response of the last one.
+//
+// Undoing is lossy for entries: undoing marking entries as read, resp. saved, marks them all as unread, resp. unsaved,
+// including those that were already read, resp. saved, before MarkerService.BulkMark.
+func (s *MarkerService) Undo(operations []MarkOperation) (*http.Response, error) {
+	return s.UndoWithContext(context.Background(), operations)
+}
+
+// UndoWithContext is like Undo but uses ctx to control the lifetime of the requests.
+func (s *MarkerService) UndoWithContext(ctx context.Context, operations []MarkOperation) (*http.Response, error) {
+	var resp *http.Response
+
+	for _, operation := range operations {
+		if _, err := inverseMarkAction(operation.Action, operation.Type); err != nil {
+			return resp, err
+		}
+	}
+
+	for _, operation := range operations {
+		var err error
+
+		resp, err = s.apply(ctx, operation)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// apply sends operation.
+func (s *MarkerService) apply(ctx context.Context, operation MarkOperation) (*http.Response, error) {
+	optionalParams := &MarkerMarkOptionalParams{
+		AsOf:            operation.AsOf,
+		LastReadEntryID: operation.LastReadEntryID,
+	}
+
+	switch operation.Type {
+	case Collections:
+		optionalParams.CollectionIDs = operation.IDs
+	case Entries:
+		optionalParams.EntryIDs = operation.IDs
+	case Feeds:
+		optionalParams.FeedIDs = operation.IDs
+	case Tags:
+		optionalParams.TagIDs = operation.IDs
+	}
+
+	return s.MarkWithContext(ctx, operation.Action, operation.Type, optionalParams)
+}
+
+// inverseMarkAction returns the action reverting markAction on markType, or "" if there is none. An error wrapping
+// ErrUnsupportedMark is returned if markAction does not apply to markType.
+func inverseMarkAction(markAction MarkAction, markType MarkType) (MarkAction, error) {
+	actions, ok := markActions[markType]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown type %q", ErrUnsupportedMark, markType)
+	}
+
+	inverseAction, ok := actions[markAction]
+	if !ok {
+		supported := make([]string, 0, len(actions))
+
+		for _, action := range []MarkAction{KeepUnread, MarkAsRead, MarkAsSaved, MarkAsUnsaved, UndoMarkAsRead} {
+			if _, ok := actions[action]; ok {
+				supported = append(supported, string(action))
+			}
+		}
+
+		return "", fmt.Errorf("%w: %s does not apply to %s, supported actions are %s", ErrUnsupportedMark, markAction, markType, strings.Join(supported, ", "))
+	}
+
+	return inverseAction, nil
+}
@@ -0,0 +1,115 @@
+package feedly_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+	pkgtime "github.com/sfanous/go-feedly/pkg/time"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// markersTransport records the bodies of the requests to the markers endpoint.
+type markersTransport struct {
+	base   http.RoundTripper
+	bodies []map[string]interface{}
+}
+
+func (t *markersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/markers") {
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		body := make(map[string]interface{})
+		if err := json.Unmarshal(b, &body); err != nil {
+			return nil, err
+		}
+
+		t.bodies = append(t.bodies, body)
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+func TestBulkMark(t *testing.T) {
+	server, entryIDs := newTestServer(t, 25)
+
+	transport := &markersTransport{base: server.Server.Client().Transport}
+	client := feedly.NewClient(&http.Client{Transport: transport}, feedly.WithAPIBaseURL(server.URL))
+
+	unread := func() int {
+		streamResponse, _, err := client.Streams.Content(testFeedID, &feedly.StreamContentOptionalParams{
+			Count:      feedly.NewInt(100),
+			UnreadOnly: feedly.NewBool(true),
+		})
+		require.NoError(t, err)
+
+		return len(streamResponse.Stream.Items)
+	}
+
+	progress := make([]int, 0)
+
+	bulkMarkResponse, _, err := client.Markers.BulkMark(feedly.MarkAsRead, feedly.Entries, entryIDs, &feedly.MarkerBulkMarkOptionalParams{
+		ChunkSize: feedly.NewInt(10),
+		OnProgress: func(marked int, total int) {
+			assert.Equal(t, len(entryIDs), total)
+
+			progress = append(progress, marked)
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{10, 20, 25}, progress)
+	require.Len(t, bulkMarkResponse.Applied, 3)
+	require.Len(t, bulkMarkResponse.Undo, 3)
+	assert.Equal(t, feedly.KeepUnread, bulkMarkResponse.Undo[0].Action)
+	assert.Equal(t, entryIDs[20:], bulkMarkResponse.Undo[0].IDs)
+	assert.Equal(t, 0, unread())
+
+	_, err = client.Markers.Undo(bulkMarkResponse.Undo)
+	require.NoError(t, err)
+	assert.Equal(t, 25, unread())
+
+	asOf := &pkgtime.Time{Time: time.Now()}
+
+	bulkMarkResponse, _, err = client.Markers.BulkMark(feedly.MarkAsRead, feedly.Feeds, []string{testFeedID}, &feedly.MarkerBulkMarkOptionalParams{
+		AsOf: asOf,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, unread())
+	require.Len(t, bulkMarkResponse.Undo, 1)
+	assert.Equal(t, feedly.UndoMarkAsRead, bulkMarkResponse.Undo[0].Action)
+	assert.Contains(t, transport.bodies[len(transport.bodies)-1], "asOf")
+
+	_, err = client.Markers.Undo(bulkMarkResponse.Undo)
+	require.NoError(t, err)
+	assert.Equal(t, 25, unread())
+	assert.Equal(t, map[string]interface{}{
+		"action":  "undoMarkAsRead",
+		"feedIds": []interface{}{testFeedID},
+		"type":    "feeds",
+	}, transport.bodies[len(transport.bodies)-1])
+
+	bulkMarkResponse, _, err = client.Markers.BulkMark(feedly.MarkAsRead, feedly.Tags, []string{feedly.TagStream(server.UserID, "global.saved").String()}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, bulkMarkResponse.Undo)
+
+	_, _, err = client.Markers.BulkMark(feedly.MarkAsSaved, feedly.Feeds, []string{testFeedID}, nil)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, feedly.ErrUnsupportedMark))
+	assert.Contains(t, err.Error(), "markAsSaved does not apply to feeds, supported actions are markAsRead, undoMarkAsRead")
+
+	_, _, err = client.Markers.BulkMark(feedly.MarkAsSaved, feedly.Entries, entryIDs, &feedly.MarkerBulkMarkOptionalParams{
+		AsOf: asOf,
+	})
+	assert.True(t, errors.Is(err, feedly.ErrUnsupportedMark))
+}
@@ -1,6 +1,7 @@
 package feedly
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/url"
@@ -25,11 +26,7 @@ func newCollectionService(sling *sling.Sling) *CollectionService {
 
 // Collection is a Feedly collection.
 type Collection struct {
-	ACL []struct {
-		Scope          *string                `json:"scope,omitempty"`
-		Target         *string                `json:"target,omitempty"`
-		UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
-	}
+	ACL            []ACLEntry             `json:"acl,omitempty"`
 	Cover          *string                `json:"cover,omitempty"`
 	Created        *time.Time             `json:"created,omitempty"`
 	Customizable   *bool                  `json:"customizable,omitempty"`
@@ -51,13 +48,18 @@ type CollectionAddFeedResponse struct {
 }
 
 // AddFeed adds a feed to an existing collection.
-func (s *CollectionService) AddFeed(collectionID string, feed *Feed) (*CollectionAddFeedResponse, *http.Response, error) {
+func (s *CollectionService) AddFeed(collectionID StreamID, feed *Feed) (*CollectionAddFeedResponse, *http.Response, error) {
+	return s.AddFeedWithContext(context.Background(), collectionID, feed)
+}
+
+// AddFeedWithContext is like AddFeed but uses ctx to control the lifetime of the request.
+func (s *CollectionService) AddFeedWithContext(ctx context.Context, collectionID StreamID, feed *Feed) (*CollectionAddFeedResponse, *http.Response, error) {
 	encodedResponse := make([]map[string]interface{}, 0)
 	decodedResponse := new(CollectionAddFeedResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Put("collections/"+url.PathEscape(collectionID)+"/feeds").BodyJSON(feed).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Put("collections/"+url.PathEscape(collectionID.String())+"/feeds").BodyJSON(feed), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -75,13 +77,18 @@ type CollectionAddMultipleFeedsResponse struct {
 }
 
 // AddMultipleFeeds adds a one or more feeds to an existing collection.
-func (s *CollectionService) AddMultipleFeeds(collectionID string, feeds []Feed) (*CollectionAddMultipleFeedsResponse, *http.Response, error) {
+func (s *CollectionService) AddMultipleFeeds(collectionID StreamID, feeds []Feed) (*CollectionAddMultipleFeedsResponse, *http.Response, error) {
+	return s.AddMultipleFeedsWithContext(context.Background(), collectionID, feeds)
+}
+
+// AddMultipleFeedsWithContext is like AddMultipleFeeds but uses ctx to control the lifetime of the request.
+func (s *CollectionService) AddMultipleFeedsWithContext(ctx context.Context, collectionID StreamID, feeds []Feed) (*CollectionAddMultipleFeedsResponse, *http.Response, error) {
 	encodedResponse := make([]map[string]interface{}, 0)
 	decodedResponse := new(CollectionAddMultipleFeedsResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("collections/"+url.PathEscape(collectionID)+"/feeds/.mput").BodyJSON(feeds).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Post("collections/"+url.PathEscape(collectionID.String())+"/feeds/.mput").BodyJSON(feeds), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -107,6 +114,11 @@ type CollectionCreateResponse struct {
 
 // Create creates a new collection.
 func (s *CollectionService) Create(label string, optionalParams *CollectionCreateOptionalParams) (*CollectionCreateResponse, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), label, optionalParams)
+}
+
+// CreateWithContext is like Create but uses ctx to control the lifetime of the request.
+func (s *CollectionService) CreateWithContext(ctx context.Context, label string, optionalParams *CollectionCreateOptionalParams) (*CollectionCreateResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &CollectionCreateOptionalParams{}
 	}
@@ -123,8 +135,8 @@ func (s *CollectionService) Create(label string, optionalParams *CollectionCreat
 	decodedResponse := new(CollectionCreateResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("collections").BodyJSON(bodyJSON).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Post("collections").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -136,12 +148,17 @@ func (s *CollectionService) Create(label string, optionalParams *CollectionCreat
 }
 
 // Delete deletes an existing collection.
-func (s *CollectionService) Delete(collectionID string) (*http.Response, error) {
+func (s *CollectionService) Delete(collectionID StreamID) (*http.Response, error) {
+	return s.DeleteWithContext(context.Background(), collectionID)
+}
+
+// DeleteWithContext is like Delete but uses ctx to control the lifetime of the request.
+func (s *CollectionService) DeleteWithContext(ctx context.Context, collectionID StreamID) (*http.Response, error) {
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Delete("collections/"+url.PathEscape(collectionID)).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Delete("collections/"+url.PathEscape(collectionID.String())), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // CollectionDeleteFeedOptionalParams are the optional parameters for CollectionService.DeleteFeed.
@@ -150,32 +167,42 @@ type CollectionDeleteFeedOptionalParams struct {
 }
 
 // DeleteFeed deletes a feed from an existing collection.
-func (s *CollectionService) DeleteFeed(collectionID string, feedID string, optionalParams *CollectionDeleteFeedOptionalParams) (*http.Response, error) {
+func (s *CollectionService) DeleteFeed(collectionID StreamID, feedID StreamID, optionalParams *CollectionDeleteFeedOptionalParams) (*http.Response, error) {
+	return s.DeleteFeedWithContext(context.Background(), collectionID, feedID, optionalParams)
+}
+
+// DeleteFeedWithContext is like DeleteFeed but uses ctx to control the lifetime of the request.
+func (s *CollectionService) DeleteFeedWithContext(ctx context.Context, collectionID StreamID, feedID StreamID, optionalParams *CollectionDeleteFeedOptionalParams) (*http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &CollectionDeleteFeedOptionalParams{}
 	}
 
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Delete("collections/"+url.PathEscape(collectionID)+"/feeds/"+url.PathEscape(feedID)).QueryStruct(optionalParams).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Delete("collections/"+url.PathEscape(collectionID.String())+"/feeds/"+url.PathEscape(feedID.String())).QueryStruct(optionalParams), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // CollectionDeleteMultipleFeedsOptionalParams are the optional parameters for CollectionService.DeleteMultipleFeeds.
 type CollectionDeleteMultipleFeedsOptionalParams CollectionDeleteFeedOptionalParams
 
 // DeleteMultipleFeeds deletes one or more feeds from an existing collection.
-func (s *CollectionService) DeleteMultipleFeeds(collectionID string, feedIDs []string, optionalParams *CollectionDeleteFeedOptionalParams) (*http.Response, error) {
+func (s *CollectionService) DeleteMultipleFeeds(collectionID StreamID, feedIDs []string, optionalParams *CollectionDeleteFeedOptionalParams) (*http.Response, error) {
+	return s.DeleteMultipleFeedsWithContext(context.Background(), collectionID, feedIDs, optionalParams)
+}
+
+// DeleteMultipleFeedsWithContext is like DeleteMultipleFeeds but uses ctx to control the lifetime of the request.
+func (s *CollectionService) DeleteMultipleFeedsWithContext(ctx context.Context, collectionID StreamID, feedIDs []string, optionalParams *CollectionDeleteFeedOptionalParams) (*http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &CollectionDeleteFeedOptionalParams{}
 	}
 
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Delete("collections/"+url.PathEscape(collectionID)+"/feeds/.mdelete").QueryStruct(optionalParams).BodyJSON(feedIDs).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Delete("collections/"+url.PathEscape(collectionID.String())+"/feeds/.mdelete").QueryStruct(optionalParams).BodyJSON(feedIDs), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // CollectionDetailResponse represents the response from CollectionService.Details.
@@ -185,13 +212,18 @@ type CollectionDetailResponse struct {
 }
 
 // Details returns details about a collection.
-func (s *CollectionService) Details(collectionID string) (*CollectionDetailResponse, *http.Response, error) {
+func (s *CollectionService) Details(collectionID StreamID) (*CollectionDetailResponse, *http.Response, error) {
+	return s.DetailsWithContext(context.Background(), collectionID)
+}
+
+// DetailsWithContext is like Details but uses ctx to control the lifetime of the request.
+func (s *CollectionService) DetailsWithContext(ctx context.Context, collectionID StreamID) (*CollectionDetailResponse, *http.Response, error) {
 	encodedResponse := make([]map[string]interface{}, 0)
 	decodedResponse := new(CollectionDetailResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("collections/"+url.PathEscape(collectionID)).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("collections/"+url.PathEscape(collectionID.String())), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -216,6 +248,11 @@ type CollectionListResponse struct {
 
 // List returns the list of collections.
 func (s *CollectionService) List(optionalParams *CollectionListOptionalParams) (*CollectionListResponse, *http.Response, error) {
+	return s.ListWithContext(context.Background(), optionalParams)
+}
+
+// ListWithContext is like List but uses ctx to control the lifetime of the request.
+func (s *CollectionService) ListWithContext(ctx context.Context, optionalParams *CollectionListOptionalParams) (*CollectionListResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &CollectionListOptionalParams{}
 	}
@@ -224,8 +261,8 @@ func (s *CollectionService) List(optionalParams *CollectionListOptionalParams) (
 	decodedResponse := new(CollectionListResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("collections").QueryStruct(optionalParams).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("collections").QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -251,7 +288,12 @@ type CollectionUpdateResponse struct {
 }
 
 // Update updates an existing collection.
-func (s *CollectionService) Update(collectionID string, optionalParams *CollectionUpdateOptionalParams) (*CollectionUpdateResponse, *http.Response, error) {
+func (s *CollectionService) Update(collectionID StreamID, optionalParams *CollectionUpdateOptionalParams) (*CollectionUpdateResponse, *http.Response, error) {
+	return s.UpdateWithContext(context.Background(), collectionID, optionalParams)
+}
+
+// UpdateWithContext is like Update but uses ctx to control the lifetime of the request.
+func (s *CollectionService) UpdateWithContext(ctx context.Context, collectionID StreamID, optionalParams *CollectionUpdateOptionalParams) (*CollectionUpdateResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &CollectionUpdateOptionalParams{}
 	}
@@ -261,15 +303,15 @@ func (s *CollectionService) Update(collectionID string, optionalParams *Collecti
 		ID string `json:"id,omitempty"`
 	}{
 		CollectionUpdateOptionalParams: optionalParams,
-		ID:                             collectionID,
+		ID:                             collectionID.String(),
 	}
 
 	encodedResponse := make([]map[string]interface{}, 0)
 	decodedResponse := new(CollectionUpdateResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("collections").BodyJSON(bodyJSON).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Post("collections").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -287,7 +329,12 @@ type CollectionUploadCoverImageResponse struct {
 }
 
 // UploadCoverImage uploads a new cover image for an existing collection.
-func (s *CollectionService) UploadCoverImage(collectionID string, coverImage io.Reader) (*CollectionUploadCoverImageResponse, *http.Response, error) {
+func (s *CollectionService) UploadCoverImage(collectionID StreamID, coverImage io.Reader) (*CollectionUploadCoverImageResponse, *http.Response, error) {
+	return s.UploadCoverImageWithContext(context.Background(), collectionID, coverImage)
+}
+
+// UploadCoverImageWithContext is like UploadCoverImage but uses ctx to control the lifetime of the request.
+func (s *CollectionService) UploadCoverImageWithContext(ctx context.Context, collectionID StreamID, coverImage io.Reader) (*CollectionUploadCoverImageResponse, *http.Response, error) {
 	body, contentType, err := mime.CreateMultipartMIMEAttachment(coverImage)
 	if err != nil {
 		return nil, nil, err
@@ -297,8 +344,8 @@ func (s *CollectionService) UploadCoverImage(collectionID string, coverImage io.
 	decodedResponse := new(CollectionUploadCoverImageResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("collections/"+url.PathEscape(collectionID)).Body(body).Set("Content-Type", contentType).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Post("collections/"+url.PathEscape(collectionID.String())).Body(body).Set("Content-Type", contentType), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -15,7 +15,7 @@ import (
 func testCollectionServiceAddFeed(t *testing.T, collection *feedly.Collection) {
 	controlCollection := controlCollections[strings.ToLower(*collection.Label)]
 
-	addFeedResponse, resp, err := client.Collections.AddFeed(*collection.ID, &feedly.Feed{
+	addFeedResponse, resp, err := client.Collections.AddFeed(feedly.StreamID(*collection.ID), &feedly.Feed{
 		ID: controlCollection.Feeds[0].ID,
 	})
 	if err != nil {
@@ -54,7 +54,7 @@ func testCollectionServiceAddMultipleFeeds(t *testing.T, collection *feedly.Coll
 
 	numberOfFeedsToAdd := rand.Intn(numberOfFeeds) + 1
 
-	addMultipleFeedsResponse, resp, err := client.Collections.AddMultipleFeeds(*collection.ID, controlCollection.Feeds[:numberOfFeedsToAdd])
+	addMultipleFeedsResponse, resp, err := client.Collections.AddMultipleFeeds(feedly.StreamID(*collection.ID), controlCollection.Feeds[:numberOfFeedsToAdd])
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -124,7 +124,7 @@ func testCollectionServiceCreate(t *testing.T, collection *feedly.Collection) {
 }
 
 func testCollectionServiceDelete(t *testing.T, collection *feedly.Collection) {
-	resp, err := client.Collections.Delete(*collection.ID)
+	resp, err := client.Collections.Delete(feedly.StreamID(*collection.ID))
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -138,7 +138,7 @@ func testCollectionServiceDelete(t *testing.T, collection *feedly.Collection) {
 func testCollectionServiceDeleteFeed(t *testing.T, collection *feedly.Collection) {
 	feedToDelete := collection.Feeds[rand.Intn(len(collection.Feeds))]
 
-	resp, err := client.Collections.DeleteFeed(*collection.ID, *feedToDelete.ID, nil)
+	resp, err := client.Collections.DeleteFeed(feedly.StreamID(*collection.ID), feedly.StreamID(*feedToDelete.ID), nil)
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -152,6 +152,8 @@ func testCollectionServiceDeleteFeed(t *testing.T, collection *feedly.Collection
 
 	assert.Nil(t, err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	removeFeeds(collection, *feedToDelete.ID)
 }
 
 func testCollectionServiceDeleteMultipleFeeds(t *testing.T, collection *feedly.Collection) {
@@ -163,7 +165,7 @@ func testCollectionServiceDeleteMultipleFeeds(t *testing.T, collection *feedly.C
 		feedIDsToDelete = append(feedIDsToDelete, *collection.Feeds[i].ID)
 	}
 
-	resp, err := client.Collections.DeleteMultipleFeeds(*collection.ID, feedIDsToDelete, nil)
+	resp, err := client.Collections.DeleteMultipleFeeds(feedly.StreamID(*collection.ID), feedIDsToDelete, nil)
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -179,10 +181,35 @@ func testCollectionServiceDeleteMultipleFeeds(t *testing.T, collection *feedly.C
 
 	assert.Nil(t, err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	removeFeeds(collection, feedIDsToDelete...)
+}
+
+// removeFeeds removes the feeds feedIDs from collection, keeping it in sync with the deletions sent to the API.
+func removeFeeds(collection *feedly.Collection, feedIDs ...string) {
+	feeds := make([]feedly.Feed, 0, len(collection.Feeds))
+
+	for _, feed := range collection.Feeds {
+		deleted := false
+
+		for _, feedID := range feedIDs {
+			if *feed.ID == feedID {
+				deleted = true
+
+				break
+			}
+		}
+
+		if !deleted {
+			feeds = append(feeds, feed)
+		}
+	}
+
+	collection.Feeds = feeds
 }
 
 func testCollectionsServiceDetails(t *testing.T, collection *feedly.Collection) {
-	detailResponse, resp, err := client.Collections.Details(*collection.ID)
+	detailResponse, resp, err := client.Collections.Details(feedly.StreamID(*collection.ID))
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -248,7 +275,7 @@ func testCollectionServiceList(t *testing.T) {
 func testCollectionServiceUpdate(t *testing.T, collection *feedly.Collection) {
 	controlCollection := controlCollections[strings.ToLower(*collection.Label)]
 
-	updateResponse, resp, err := client.Collections.Update(*collection.ID, &feedly.CollectionUpdateOptionalParams{
+	updateResponse, resp, err := client.Collections.Update(feedly.StreamID(*collection.ID), &feedly.CollectionUpdateOptionalParams{
 		DeleteCover: feedly.NewBool(true),
 		Description: feedly.NewString(*collection.Label + " updated by go-feedly for testing"),
 		Label:       feedly.NewString(strings.ToUpper(*collection.Label)),
@@ -297,7 +324,7 @@ func testCollectionServiceUploadCoverImage(t *testing.T, collection *feedly.Coll
 		}
 	}()
 
-	uploadCoverImageResponse, resp, err := client.Collections.UploadCoverImage(*collection.ID, coverImage)
+	uploadCoverImageResponse, resp, err := client.Collections.UploadCoverImage(feedly.StreamID(*collection.ID), coverImage)
 	if err != nil {
 		t.Errorf("%v", err)
 	}
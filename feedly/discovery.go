@@ -0,0 +1,341 @@
+package feedly
+
+import (
+	"context"
+	"errors"
+	"html"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// maxDiscoveryBodySize is the maximum number of bytes of a website read by FeedService.Discover.
+const maxDiscoveryBodySize = 1 << 20
+
+var (
+	// linkTagPattern matches the HTML link tags of a page.
+	linkTagPattern = regexp.MustCompile(`(?is)<link\b[^>]*>`)
+	// attributePattern matches the attributes of an HTML tag.
+	attributePattern = regexp.MustCompile(`(?s)([a-zA-Z_:][-a-zA-Z0-9_:.]*)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'=<>` + "`" + `]+))`)
+)
+
+// feedMediaTypes are the media types of the feeds advertised by websites, in order of preference. Among the feeds
+// equally ranked by FeedService.Discover, those advertised with a preferred media type come first.
+var feedMediaTypes = []string{
+	"application/atom+xml",
+	"application/rss+xml",
+	"application/feed+json",
+	"application/rdf+xml",
+}
+
+// FeedDiscoverOptionalParams are the optional parameters for FeedService.Discover.
+type FeedDiscoverOptionalParams struct {
+	// HTTPClient fetches the website whose feed links are parsed. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// SkipHTML, if true, disables fetching the website, so only Feedly search is used.
+	SkipHTML *bool
+	// SkipSearch, if true, disables Feedly search, so only the feed links of the website are used.
+	SkipSearch *bool
+}
+
+// FeedDiscoverResponse represents the response from FeedService.Discover.
+type FeedDiscoverResponse struct {
+	// Feeds are the candidate feeds, best first: feeds both advertised by the website and known to Feedly, then feeds
+	// advertised by the website, then feeds found by Feedly search, each group ordered by the preference of the media
+	// type the feed was advertised with, then by number of subscribers.
+	Feeds []Feed
+	// Errors are the errors of the sources that failed while another succeeded.
+	Errors []error
+}
+
+// Discover returns the candidate feeds of the website at websiteURL, found by both querying Feedly search with the URL
+// and parsing the <link rel="alternate"> tags of the website. The returned feeds are ready to be passed to
+// CollectionService.AddFeed. An error is returned only if every source failed. The response returned is the one of the
+// last request sent, to Feedly search unless it is skipped.
+func (s *FeedService) Discover(websiteURL string, optionalParams *FeedDiscoverOptionalParams) (*FeedDiscoverResponse, *http.Response, error) {
+	return s.DiscoverWithContext(context.Background(), websiteURL, optionalParams)
+}
+
+// DiscoverWithContext is like Discover but uses ctx to control the lifetime of the requests.
+func (s *FeedService) DiscoverWithContext(ctx context.Context, websiteURL string, optionalParams *FeedDiscoverOptionalParams) (*FeedDiscoverResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &FeedDiscoverOptionalParams{}
+	}
+
+	if !strings.Contains(websiteURL, "://") {
+		websiteURL = "https://" + websiteURL
+	}
+
+	candidates := newFeedCandidates()
+
+	var errs []error
+	var resp *http.Response
+
+	if optionalParams.SkipHTML == nil || !*optionalParams.SkipHTML {
+		httpClient := optionalParams.HTTPClient
+		if httpClient == nil {
+			httpClient = http.DefaultClient
+		}
+
+		var feeds []advertisedFeed
+		var err error
+
+		feeds, resp, err = discoverHTMLFeeds(ctx, httpClient, websiteURL)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		for _, feed := range feeds {
+			candidates.add(feed.Feed, feed.mediaType)
+		}
+	}
+
+	if optionalParams.SkipSearch == nil || !*optionalParams.SkipSearch {
+		var searchResponse *SearchFeedsResponse
+		var err error
+
+		searchResponse, resp, err = s.search.FeedsWithContext(ctx, websiteURL, nil)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			for _, feed := range searchResponse.Results {
+				candidates.add(feed, "")
+			}
+		}
+	}
+
+	if len(errs) > 0 && len(candidates.feeds) == 0 {
+		return nil, resp, errs[0]
+	}
+
+	return &FeedDiscoverResponse{
+		Feeds:  candidates.ranked(),
+		Errors: errs,
+	}, resp, nil
+}
+
+// feedCandidates merges the feeds found by the sources of FeedService.Discover.
+type feedCandidates struct {
+	feeds      []Feed
+	index      map[string]int
+	advertised map[string]bool
+	searched   map[string]bool
+	// preference maps the IDs of the advertised feeds to the preference of their media type, see mediaTypePreference.
+	preference map[string]int
+}
+
+// newFeedCandidates returns an empty feedCandidates.
+func newFeedCandidates() *feedCandidates {
+	return &feedCandidates{
+		index:      make(map[string]int),
+		advertised: make(map[string]bool),
+		searched:   make(map[string]bool),
+		preference: make(map[string]int),
+	}
+}
+
+// add adds feed, advertised by the website with the media type mediaType, or found by Feedly search if mediaType is
+// empty. The metadata returned by Feedly search replaces the one parsed from the website.
+func (c *feedCandidates) add(feed Feed, mediaType string) {
+	if feed.ID == nil {
+		return
+	}
+
+	id := *feed.ID
+	advertised := mediaType != ""
+
+	i, ok := c.index[id]
+	if !ok {
+		i = len(c.feeds)
+		c.index[id] = i
+		c.feeds = append(c.feeds, feed)
+	} else if !advertised {
+		c.feeds[i] = feed
+	}
+
+	if advertised {
+		c.advertised[id] = true
+		c.preference[id] = mediaTypePreference(mediaType)
+	} else {
+		c.searched[id] = true
+	}
+}
+
+// ranked returns the feeds, best first.
+func (c *feedCandidates) ranked() []Feed {
+	rank := func(feed Feed) int {
+		r := 0
+
+		if c.advertised[*feed.ID] {
+			r += 2
+		}
+
+		if c.searched[*feed.ID] {
+			r++
+		}
+
+		return r
+	}
+
+	preference := func(feed Feed) int {
+		if p, ok := c.preference[*feed.ID]; ok {
+			return p
+		}
+
+		return len(feedMediaTypes)
+	}
+
+	subscribers := func(feed Feed) int {
+		if feed.Subscribers == nil {
+			return 0
+		}
+
+		return *feed.Subscribers
+	}
+
+	feeds := append([]Feed(nil), c.feeds...)
+
+	sort.SliceStable(feeds, func(i int, j int) bool {
+		if rank(feeds[i]) != rank(feeds[j]) {
+			return rank(feeds[i]) > rank(feeds[j])
+		}
+
+		if preference(feeds[i]) != preference(feeds[j]) {
+			return preference(feeds[i]) < preference(feeds[j])
+		}
+
+		return subscribers(feeds[i]) > subscribers(feeds[j])
+	})
+
+	return feeds
+}
+
+// advertisedFeed is a feed advertised by a website, along with the media type it was advertised with.
+type advertisedFeed struct {
+	Feed
+	mediaType string
+}
+
+// discoverHTMLFeeds fetches the page at pageURL and returns the feeds it advertises, along with the response. If the
+// page is itself a feed, it is returned.
+func discoverHTMLFeeds(ctx context.Context, httpClient *http.Client, pageURL string) ([]advertisedFeed, *http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", "text/html, application/xhtml+xml, application/atom+xml, application/rss+xml;q=0.9, */*;q=0.8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, resp, errors.New("feedly: fetching " + pageURL + ": " + resp.Status)
+	}
+
+	base := resp.Request.URL
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if isFeedMediaType(mediaType) || mediaType == "application/xml" || mediaType == "text/xml" {
+		return []advertisedFeed{{
+			Feed: Feed{
+				FeedID: NewString(FeedStream(base.String()).String()),
+				ID:     NewString(FeedStream(base.String()).String()),
+			},
+			mediaType: mediaType,
+		}}, resp, nil
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxDiscoveryBodySize))
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return parseFeedLinks(string(body), base), resp, nil
+}
+
+// parseFeedLinks returns the feeds advertised by the <link rel="alternate"> tags of the HTML page page, resolving
+// relative links against base.
+func parseFeedLinks(page string, base *url.URL) []advertisedFeed {
+	feeds := make([]advertisedFeed, 0)
+	seen := make(map[string]struct{})
+
+	for _, tag := range linkTagPattern.FindAllString(page, -1) {
+		attributes := make(map[string]string)
+
+		for _, match := range attributePattern.FindAllStringSubmatch(tag, -1) {
+			attributes[strings.ToLower(match[1])] = html.UnescapeString(match[2] + match[3] + match[4])
+		}
+
+		mediaType := strings.ToLower(strings.TrimSpace(attributes["type"]))
+		if !hasToken(attributes["rel"], "alternate") || !isFeedMediaType(mediaType) {
+			continue
+		}
+
+		href, err := base.Parse(strings.TrimSpace(attributes["href"]))
+		if err != nil || (href.Scheme != "http" && href.Scheme != "https") {
+			continue
+		}
+
+		id := FeedStream(href.String()).String()
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+
+		feed := Feed{
+			FeedID:  NewString(id),
+			ID:      NewString(id),
+			Website: NewString(base.String()),
+		}
+
+		if title := strings.TrimSpace(attributes["title"]); title != "" {
+			feed.Title = NewString(title)
+		}
+
+		feeds = append(feeds, advertisedFeed{
+			Feed:      feed,
+			mediaType: mediaType,
+		})
+	}
+
+	return feeds
+}
+
+// hasToken reports whether the space separated list of tokens list contains token, ignoring case.
+func hasToken(list string, token string) bool {
+	for _, t := range strings.Fields(list) {
+		if strings.EqualFold(t, token) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isFeedMediaType reports whether mediaType is the media type of a feed.
+func isFeedMediaType(mediaType string) bool {
+	return mediaTypePreference(mediaType) < len(feedMediaTypes)
+}
+
+// mediaTypePreference returns the index of mediaType in feedMediaTypes, lower being preferred, or len(feedMediaTypes)
+// if mediaType is not the media type of a feed.
+func mediaTypePreference(mediaType string) int {
+	for i, feedMediaType := range feedMediaTypes {
+		if mediaType == feedMediaType {
+			return i
+		}
+	}
+
+	return len(feedMediaTypes)
+}
@@ -0,0 +1,93 @@
+package feedly_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscover(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(`<html><head>
+<link rel="stylesheet" href="/style.css">
+<link rel="alternate" type="application/rdf+xml" href="/rdf">
+<link rel="alternate" type="application/json" href="/data.json">
+<LINK REL="alternate" TYPE="application/atom+xml" TITLE="Atom &amp; more" HREF="/atom.xml">
+<link rel='alternate' type='application/rss+xml' href='/rss'>
+<link rel="alternate" type="application/rss+xml" href="/rss">
+<link rel="alternate" hreflang="fr" href="/fr/">
+</head></html>`))
+		case "/rss":
+			w.Header().Set("Content-Type", "application/rss+xml")
+			_, _ = w.Write([]byte(`<rss version="2.0"></rss>`))
+		case "/data.json":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer site.Close()
+
+	server, _ := newTestServer(t, 0)
+	client := server.Client()
+
+	server.AddFeed(feedly.Feed{
+		ID:          feedly.NewString("feed/" + site.URL + "/rss"),
+		Subscribers: feedly.NewInt(10),
+		Title:       feedly.NewString("Site"),
+		Website:     feedly.NewString(site.URL),
+	})
+	server.AddFeed(feedly.Feed{
+		ID:          feedly.NewString("feed/" + site.URL + "/comments"),
+		Subscribers: feedly.NewInt(5),
+		Title:       feedly.NewString("Site comments"),
+		Website:     feedly.NewString(site.URL + "/blog"),
+	})
+
+	discoverResponse, resp, err := client.Feeds.Discover(site.URL, &feedly.FeedDiscoverOptionalParams{HTTPClient: site.Client()})
+	require.NoError(t, err)
+	assert.Equal(t, "/v3/search/feeds", resp.Request.URL.Path)
+	assert.Empty(t, discoverResponse.Errors)
+	require.Len(t, discoverResponse.Feeds, 4)
+	assert.Equal(t, "feed/"+site.URL+"/rss", *discoverResponse.Feeds[0].ID)
+	assert.Equal(t, "Site", *discoverResponse.Feeds[0].Title)
+	assert.Equal(t, "feed/"+site.URL+"/atom.xml", *discoverResponse.Feeds[1].ID)
+	assert.Equal(t, "Atom & more", *discoverResponse.Feeds[1].Title)
+	assert.Equal(t, "feed/"+site.URL+"/rdf", *discoverResponse.Feeds[2].ID)
+	assert.Equal(t, "feed/"+site.URL+"/comments", *discoverResponse.Feeds[3].ID)
+
+	discoverResponse, resp, err = client.Feeds.Discover(site.URL+"/rss", &feedly.FeedDiscoverOptionalParams{HTTPClient: site.Client(), SkipSearch: feedly.NewBool(true)})
+	require.NoError(t, err)
+	assert.Equal(t, site.URL+"/rss", resp.Request.URL.String())
+	require.Len(t, discoverResponse.Feeds, 1)
+	assert.Equal(t, "feed/"+site.URL+"/rss", *discoverResponse.Feeds[0].ID)
+
+	discoverResponse, _, err = client.Feeds.Discover(site.URL+"/data.json", &feedly.FeedDiscoverOptionalParams{HTTPClient: site.Client(), SkipSearch: feedly.NewBool(true)})
+	require.NoError(t, err)
+	assert.Empty(t, discoverResponse.Feeds)
+
+	discoverResponse, _, err = client.Feeds.Discover(site.URL+"/blog", &feedly.FeedDiscoverOptionalParams{HTTPClient: site.Client()})
+	require.NoError(t, err)
+	assert.Len(t, discoverResponse.Errors, 1)
+	require.Len(t, discoverResponse.Feeds, 1)
+	assert.Equal(t, "feed/"+site.URL+"/comments", *discoverResponse.Feeds[0].ID)
+
+	_, resp, err = client.Feeds.Discover(site.URL+"/blog", &feedly.FeedDiscoverOptionalParams{HTTPClient: site.Client(), SkipSearch: feedly.NewBool(true)})
+	assert.Error(t, err)
+	require.NotNil(t, resp)
+	assert.NotEqual(t, http.StatusOK, resp.StatusCode)
+
+	createResponse, _, err := client.Collections.Create("discovered", nil)
+	require.NoError(t, err)
+
+	_, _, err = client.Collections.AddFeed(feedly.StreamID(*createResponse.Collections[0].ID), &discoverResponse.Feeds[0])
+	assert.NoError(t, err)
+}
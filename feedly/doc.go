@@ -12,6 +12,12 @@ made by using the appropriate authenticated client.
 Use the https://github.com/golang/oauth2 package to obtain an http.Client which
 transparently authorizes requests.
 
+Errors
+
+Unsuccessful responses are returned as an *APIError carrying the HTTP status code
+and the request method and path. Use errors.Is with ErrUnauthorized, ErrNotFound,
+ErrRateLimited, or ErrProRequired to handle the most common failures.
+
 Usage
 
 You use the library by creating a Client and invoking its methods. The client
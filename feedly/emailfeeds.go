@@ -0,0 +1,114 @@
+package feedly
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+	"github.com/sfanous/go-feedly/internal/mapstructure"
+	"github.com/sfanous/go-feedly/pkg/time"
+)
+
+// EmailFeedService provides methods for managing email feeds, the inboxes turning the newsletters they receive into
+// feed entries.
+type EmailFeedService struct {
+	sling *sling.Sling
+}
+
+// newEmailFeedService returns a new EmailFeedService.
+func newEmailFeedService(sling *sling.Sling) *EmailFeedService {
+	return &EmailFeedService{
+		sling: sling,
+	}
+}
+
+// EmailFeed is a Feedly email feed.
+type EmailFeed struct {
+	Created *time.Time `json:"created,omitempty"`
+	// Email is the inbox address newsletters should be sent to.
+	Email *string `json:"email,omitempty"`
+	ID    *string `json:"id,omitempty"`
+	// StreamID is the ID of the feed containing the received newsletters.
+	StreamID       *string                `json:"streamId,omitempty"`
+	Title          *string                `json:"title,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// EmailFeedCreateResponse represents the response from EmailFeedService.Create.
+type EmailFeedCreateResponse struct {
+	EmailFeed      *EmailFeed             `json:"emailFeed"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Create creates a new email feed titled title, and returns it along with its inbox address.
+func (s *EmailFeedService) Create(title string) (*EmailFeedCreateResponse, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), title)
+}
+
+// CreateWithContext is like Create but uses ctx to control the lifetime of the request.
+func (s *EmailFeedService) CreateWithContext(ctx context.Context, title string) (*EmailFeedCreateResponse, *http.Response, error) {
+	body := struct {
+		Title string `json:"title"`
+	}{
+		Title: title,
+	}
+
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(EmailFeedCreateResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("emailfeeds").BodyJSON(&body), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.EmailFeed); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// Delete deletes an existing email feed. Newsletters sent to its inbox address are no longer received.
+func (s *EmailFeedService) Delete(emailFeedID string) (*http.Response, error) {
+	return s.DeleteWithContext(context.Background(), emailFeedID)
+}
+
+// DeleteWithContext is like Delete but uses ctx to control the lifetime of the request.
+func (s *EmailFeedService) DeleteWithContext(ctx context.Context, emailFeedID string) (*http.Response, error) {
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Delete("emailfeeds/"+url.PathEscape(emailFeedID)), nil, apiError)
+
+	return resp, relevantError(resp, err, apiError)
+}
+
+// EmailFeedListResponse represents the response from EmailFeedService.List.
+type EmailFeedListResponse struct {
+	EmailFeeds     []EmailFeed            `json:"emailFeeds"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// List returns the list of email feeds.
+func (s *EmailFeedService) List() (*EmailFeedListResponse, *http.Response, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but uses ctx to control the lifetime of the request.
+func (s *EmailFeedService) ListWithContext(ctx context.Context) (*EmailFeedListResponse, *http.Response, error) {
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(EmailFeedListResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("emailfeeds"), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.EmailFeeds); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
@@ -0,0 +1,40 @@
+package feedly_test
+
+import (
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailFeeds(t *testing.T) {
+	server, _ := newTestServer(t, 0)
+	client := server.Client()
+
+	createResponse, _, err := client.EmailFeeds.Create("Newsletters")
+	require.NoError(t, err)
+	require.NotNil(t, createResponse.EmailFeed.Email)
+	assert.Contains(t, *createResponse.EmailFeed.Email, "@")
+	assert.Equal(t, feedly.FeedStreamType, feedly.StreamID(*createResponse.EmailFeed.StreamID).Type())
+
+	_, _, err = client.EmailFeeds.Create("")
+	assert.Error(t, err)
+
+	listResponse, _, err := client.EmailFeeds.List()
+	require.NoError(t, err)
+	require.Len(t, listResponse.EmailFeeds, 1)
+	assert.Equal(t, *createResponse.EmailFeed.StreamID, *listResponse.EmailFeeds[0].StreamID)
+
+	subscriptionsResponse, _, err := client.Subscriptions.List()
+	require.NoError(t, err)
+	require.Len(t, subscriptionsResponse.Feeds, 1)
+	assert.Equal(t, *createResponse.EmailFeed.StreamID, *subscriptionsResponse.Feeds[0].ID)
+
+	_, err = client.EmailFeeds.Delete(*createResponse.EmailFeed.ID)
+	require.NoError(t, err)
+
+	listResponse, _, err = client.EmailFeeds.List()
+	require.NoError(t, err)
+	assert.Empty(t, listResponse.EmailFeeds)
+}
@@ -0,0 +1,365 @@
+package feedly
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+	"github.com/sfanous/go-feedly/internal/mapstructure"
+	"github.com/sfanous/go-feedly/pkg/time"
+)
+
+// EnterpriseService provides methods for managing the team collections, aka team feeds, and team boards of a Feedly
+// Enterprise account, along with who may access them. Team streams are identified by enterprise stream IDs, such as
+// EnterpriseCategory and EnterpriseTag return.
+type EnterpriseService struct {
+	sling *sling.Sling
+}
+
+// newEnterpriseService returns a new EnterpriseService.
+func newEnterpriseService(sling *sling.Sling) *EnterpriseService {
+	return &EnterpriseService{
+		sling: sling,
+	}
+}
+
+// ACLEntry grants access to a team collection or board.
+type ACLEntry struct {
+	// Scope is the access granted, such as "read" or "write".
+	Scope *string `json:"scope,omitempty"`
+	// Target is who is granted access: the ID of a team member, or the ID of a group of the enterprise such as
+	// "enterprise/<enterpriseName>/group/global.all".
+	Target         *string                `json:"target,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// TeamMember is a member of a Feedly Enterprise team.
+type TeamMember struct {
+	Created  *time.Time `json:"created,omitempty"`
+	Email    *string    `json:"email,omitempty"`
+	FullName *string    `json:"fullName,omitempty"`
+	ID       *string    `json:"id,omitempty"`
+	Picture  *string    `json:"picture,omitempty"`
+	// Role is the role of the member in the team, such as "admin" or "member".
+	Role           *string                `json:"role,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// EnterpriseCreateBoardOptionalParams are the optional parameters for EnterpriseService.CreateBoard.
+type EnterpriseCreateBoardOptionalParams struct {
+	ACL         []ACLEntry `json:"acl,omitempty"`
+	Description *string    `json:"description,omitempty"`
+}
+
+// EnterpriseCreateBoardResponse represents the response from EnterpriseService.CreateBoard.
+type EnterpriseCreateBoardResponse struct {
+	Boards         []Board                `json:"boards"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// CreateBoard creates a new team board.
+func (s *EnterpriseService) CreateBoard(label string, optionalParams *EnterpriseCreateBoardOptionalParams) (*EnterpriseCreateBoardResponse, *http.Response, error) {
+	return s.CreateBoardWithContext(context.Background(), label, optionalParams)
+}
+
+// CreateBoardWithContext is like CreateBoard but uses ctx to control the lifetime of the request.
+func (s *EnterpriseService) CreateBoardWithContext(ctx context.Context, label string, optionalParams *EnterpriseCreateBoardOptionalParams) (*EnterpriseCreateBoardResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &EnterpriseCreateBoardOptionalParams{}
+	}
+
+	bodyJSON := &struct {
+		*EnterpriseCreateBoardOptionalParams
+		Label string `json:"label"`
+	}{
+		EnterpriseCreateBoardOptionalParams: optionalParams,
+		Label:                               label,
+	}
+
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(EnterpriseCreateBoardResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("enterprise/tags").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Boards); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// EnterpriseCreateCollectionOptionalParams are the optional parameters for EnterpriseService.CreateCollection.
+type EnterpriseCreateCollectionOptionalParams struct {
+	ACL         []ACLEntry `json:"acl,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Feeds       []Feed     `json:"feeds,omitempty"`
+}
+
+// EnterpriseCreateCollectionResponse represents the response from EnterpriseService.CreateCollection.
+type EnterpriseCreateCollectionResponse struct {
+	Collections    []Collection           `json:"collections"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// CreateCollection creates a new team collection.
+func (s *EnterpriseService) CreateCollection(label string, optionalParams *EnterpriseCreateCollectionOptionalParams) (*EnterpriseCreateCollectionResponse, *http.Response, error) {
+	return s.CreateCollectionWithContext(context.Background(), label, optionalParams)
+}
+
+// CreateCollectionWithContext is like CreateCollection but uses ctx to control the lifetime of the request.
+func (s *EnterpriseService) CreateCollectionWithContext(ctx context.Context, label string, optionalParams *EnterpriseCreateCollectionOptionalParams) (*EnterpriseCreateCollectionResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &EnterpriseCreateCollectionOptionalParams{}
+	}
+
+	bodyJSON := &struct {
+		*EnterpriseCreateCollectionOptionalParams
+		Label string `json:"label"`
+	}{
+		EnterpriseCreateCollectionOptionalParams: optionalParams,
+		Label:                                    label,
+	}
+
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(EnterpriseCreateCollectionResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("enterprise/collections").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Collections); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// DeleteBoard deletes an existing team board.
+func (s *EnterpriseService) DeleteBoard(boardID StreamID) (*http.Response, error) {
+	return s.DeleteBoardWithContext(context.Background(), boardID)
+}
+
+// DeleteBoardWithContext is like DeleteBoard but uses ctx to control the lifetime of the request.
+func (s *EnterpriseService) DeleteBoardWithContext(ctx context.Context, boardID StreamID) (*http.Response, error) {
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Delete("enterprise/tags/"+url.PathEscape(boardID.String())), nil, apiError)
+
+	return resp, relevantError(resp, err, apiError)
+}
+
+// DeleteCollection deletes an existing team collection.
+func (s *EnterpriseService) DeleteCollection(collectionID StreamID) (*http.Response, error) {
+	return s.DeleteCollectionWithContext(context.Background(), collectionID)
+}
+
+// DeleteCollectionWithContext is like DeleteCollection but uses ctx to control the lifetime of the request.
+func (s *EnterpriseService) DeleteCollectionWithContext(ctx context.Context, collectionID StreamID) (*http.Response, error) {
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Delete("enterprise/collections/"+url.PathEscape(collectionID.String())), nil, apiError)
+
+	return resp, relevantError(resp, err, apiError)
+}
+
+// EnterpriseListBoardsResponse represents the response from EnterpriseService.ListBoards.
+type EnterpriseListBoardsResponse struct {
+	Boards         []Board                `json:"boards"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// ListBoards returns the list of team boards.
+func (s *EnterpriseService) ListBoards() (*EnterpriseListBoardsResponse, *http.Response, error) {
+	return s.ListBoardsWithContext(context.Background())
+}
+
+// ListBoardsWithContext is like ListBoards but uses ctx to control the lifetime of the request.
+func (s *EnterpriseService) ListBoardsWithContext(ctx context.Context) (*EnterpriseListBoardsResponse, *http.Response, error) {
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(EnterpriseListBoardsResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("enterprise/tags"), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Boards); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// EnterpriseListCollectionsOptionalParams are the optional parameters for EnterpriseService.ListCollections.
+type EnterpriseListCollectionsOptionalParams struct {
+	WithStats *bool `url:"withStats,omitempty"`
+}
+
+// EnterpriseListCollectionsResponse represents the response from EnterpriseService.ListCollections.
+type EnterpriseListCollectionsResponse struct {
+	Collections    []Collection           `json:"collections"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// ListCollections returns the list of team collections.
+func (s *EnterpriseService) ListCollections(optionalParams *EnterpriseListCollectionsOptionalParams) (*EnterpriseListCollectionsResponse, *http.Response, error) {
+	return s.ListCollectionsWithContext(context.Background(), optionalParams)
+}
+
+// ListCollectionsWithContext is like ListCollections but uses ctx to control the lifetime of the request.
+func (s *EnterpriseService) ListCollectionsWithContext(ctx context.Context, optionalParams *EnterpriseListCollectionsOptionalParams) (*EnterpriseListCollectionsResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &EnterpriseListCollectionsOptionalParams{}
+	}
+
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(EnterpriseListCollectionsResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("enterprise/collections").QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Collections); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// EnterpriseListMembersResponse represents the response from EnterpriseService.ListMembers.
+type EnterpriseListMembersResponse struct {
+	Members        []TeamMember           `json:"members"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// ListMembers returns the list of team members.
+func (s *EnterpriseService) ListMembers() (*EnterpriseListMembersResponse, *http.Response, error) {
+	return s.ListMembersWithContext(context.Background())
+}
+
+// ListMembersWithContext is like ListMembers but uses ctx to control the lifetime of the request.
+func (s *EnterpriseService) ListMembersWithContext(ctx context.Context) (*EnterpriseListMembersResponse, *http.Response, error) {
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(EnterpriseListMembersResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("enterprise/members"), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Members); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// EnterpriseUpdateBoardOptionalParams are the optional parameters for EnterpriseService.UpdateBoard.
+type EnterpriseUpdateBoardOptionalParams struct {
+	// ACL, if set, replaces the access list of the board.
+	ACL         []ACLEntry `json:"acl,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Label       *string    `json:"label,omitempty"`
+}
+
+// EnterpriseUpdateBoardResponse represents the response from EnterpriseService.UpdateBoard.
+type EnterpriseUpdateBoardResponse struct {
+	Boards         []Board                `json:"boards"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// UpdateBoard updates an existing team board.
+func (s *EnterpriseService) UpdateBoard(boardID StreamID, optionalParams *EnterpriseUpdateBoardOptionalParams) (*EnterpriseUpdateBoardResponse, *http.Response, error) {
+	return s.UpdateBoardWithContext(context.Background(), boardID, optionalParams)
+}
+
+// UpdateBoardWithContext is like UpdateBoard but uses ctx to control the lifetime of the request.
+func (s *EnterpriseService) UpdateBoardWithContext(ctx context.Context, boardID StreamID, optionalParams *EnterpriseUpdateBoardOptionalParams) (*EnterpriseUpdateBoardResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &EnterpriseUpdateBoardOptionalParams{}
+	}
+
+	bodyJSON := &struct {
+		*EnterpriseUpdateBoardOptionalParams
+		ID string `json:"id"`
+	}{
+		EnterpriseUpdateBoardOptionalParams: optionalParams,
+		ID:                                  boardID.String(),
+	}
+
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(EnterpriseUpdateBoardResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("enterprise/tags").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Boards); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// EnterpriseUpdateCollectionOptionalParams are the optional parameters for EnterpriseService.UpdateCollection.
+type EnterpriseUpdateCollectionOptionalParams struct {
+	// ACL, if set, replaces the access list of the collection.
+	ACL         []ACLEntry `json:"acl,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Feeds       []Feed     `json:"feeds,omitempty"`
+	Label       *string    `json:"label,omitempty"`
+}
+
+// EnterpriseUpdateCollectionResponse represents the response from EnterpriseService.UpdateCollection.
+type EnterpriseUpdateCollectionResponse struct {
+	Collections    []Collection           `json:"collections"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// UpdateCollection updates an existing team collection. Feeds are added to the collection.
+func (s *EnterpriseService) UpdateCollection(collectionID StreamID, optionalParams *EnterpriseUpdateCollectionOptionalParams) (*EnterpriseUpdateCollectionResponse, *http.Response, error) {
+	return s.UpdateCollectionWithContext(context.Background(), collectionID, optionalParams)
+}
+
+// UpdateCollectionWithContext is like UpdateCollection but uses ctx to control the lifetime of the request.
+func (s *EnterpriseService) UpdateCollectionWithContext(ctx context.Context, collectionID StreamID, optionalParams *EnterpriseUpdateCollectionOptionalParams) (*EnterpriseUpdateCollectionResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &EnterpriseUpdateCollectionOptionalParams{}
+	}
+
+	bodyJSON := &struct {
+		*EnterpriseUpdateCollectionOptionalParams
+		ID string `json:"id"`
+	}{
+		EnterpriseUpdateCollectionOptionalParams: optionalParams,
+		ID:                                       collectionID.String(),
+	}
+
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(EnterpriseUpdateCollectionResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("enterprise/collections").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Collections); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
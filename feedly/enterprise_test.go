@@ -0,0 +1,115 @@
+package feedly_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/sfanous/go-feedly/feedly/feedlytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnterprise(t *testing.T) {
+	server, _ := newTestServer(t, 0)
+
+	_, _, err := server.Client().Enterprise.ListCollections(nil)
+	assert.True(t, errors.Is(err, feedly.ErrProRequired))
+
+	server = feedlytest.NewEnterpriseServer("acme")
+	t.Cleanup(server.Close)
+
+	client := server.Client()
+	entryIDs := server.AddEntries(testFeedID, feedly.Entry{Title: feedly.NewString("Entry")})
+
+	createCollectionResponse, _, err := client.Enterprise.CreateCollection("Competitors", &feedly.EnterpriseCreateCollectionOptionalParams{
+		Feeds: []feedly.Feed{{ID: feedly.NewString(testFeedID)}},
+	})
+	require.NoError(t, err)
+	require.Len(t, createCollectionResponse.Collections, 1)
+
+	collection := createCollectionResponse.Collections[0]
+	collectionID := feedly.StreamID(*collection.ID)
+	assert.Equal(t, feedly.EnterpriseCategoryStreamType, collectionID.Type())
+	assert.Equal(t, "acme", collectionID.EnterpriseName())
+	assert.True(t, *collection.Enterprise)
+	require.Len(t, collection.ACL, 1)
+
+	contentResponse, _, err := client.Streams.Content(feedly.StreamID(collectionID.String()), nil)
+	require.NoError(t, err)
+	require.Len(t, contentResponse.Stream.Items, 1)
+	assert.Equal(t, "Competitors", *contentResponse.Stream.Title)
+
+	updateCollectionResponse, _, err := client.Enterprise.UpdateCollection(feedly.StreamID(collectionID.String()), &feedly.EnterpriseUpdateCollectionOptionalParams{
+		ACL: []feedly.ACLEntry{{
+			Scope:  feedly.NewString("read"),
+			Target: feedly.NewString(server.UserID),
+		}},
+		Label: feedly.NewString("Rivals"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Rivals", *updateCollectionResponse.Collections[0].Label)
+	require.Len(t, updateCollectionResponse.Collections[0].ACL, 1)
+	assert.Equal(t, "read", *updateCollectionResponse.Collections[0].ACL[0].Scope)
+
+	listCollectionsResponse, _, err := client.Enterprise.ListCollections(&feedly.EnterpriseListCollectionsOptionalParams{
+		WithStats: feedly.NewBool(true),
+	})
+	require.NoError(t, err)
+	require.Len(t, listCollectionsResponse.Collections, 1)
+	assert.Equal(t, 1, *listCollectionsResponse.Collections[0].NumFeeds)
+
+	personalResponse, _, err := client.Collections.List(nil)
+	require.NoError(t, err)
+	assert.Empty(t, personalResponse.Collections)
+
+	personalResponse, _, err = client.Collections.List(&feedly.CollectionListOptionalParams{WithEnterprise: feedly.NewBool(true)})
+	require.NoError(t, err)
+	assert.Len(t, personalResponse.Collections, 1)
+
+	createBoardResponse, _, err := client.Enterprise.CreateBoard("Reports", nil)
+	require.NoError(t, err)
+
+	boardID := *createBoardResponse.Boards[0].ID
+	assert.Equal(t, feedly.EnterpriseTagStreamType, feedly.StreamID(boardID).Type())
+
+	_, err = client.Boards.AddEntry([]string{boardID}, entryIDs[0])
+	require.NoError(t, err)
+
+	contentResponse, _, err = client.Streams.Content(feedly.StreamID(boardID), nil)
+	require.NoError(t, err)
+	require.Len(t, contentResponse.Stream.Items, 1)
+	require.Len(t, contentResponse.Stream.Items[0].Tags, 1)
+	assert.Equal(t, "Reports", *contentResponse.Stream.Items[0].Tags[0].Label)
+
+	updateBoardResponse, _, err := client.Enterprise.UpdateBoard(feedly.StreamID(boardID), &feedly.EnterpriseUpdateBoardOptionalParams{
+		Description: feedly.NewString("Weekly reports"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Weekly reports", *updateBoardResponse.Boards[0].Description)
+
+	listBoardsResponse, _, err := client.Enterprise.ListBoards()
+	require.NoError(t, err)
+	assert.Len(t, listBoardsResponse.Boards, 1)
+
+	server.AddTeamMember(feedly.TeamMember{
+		Email: feedly.NewString("jane@example.com"),
+		ID:    feedly.NewString("11111111-1111-1111-1111-111111111111"),
+	})
+
+	membersResponse, _, err := client.Enterprise.ListMembers()
+	require.NoError(t, err)
+	require.Len(t, membersResponse.Members, 2)
+	assert.Equal(t, "admin", *membersResponse.Members[0].Role)
+	assert.Equal(t, "member", *membersResponse.Members[1].Role)
+
+	_, err = client.Enterprise.DeleteBoard(feedly.StreamID(boardID))
+	require.NoError(t, err)
+
+	_, err = client.Enterprise.DeleteCollection(feedly.StreamID(collectionID.String()))
+	require.NoError(t, err)
+
+	listCollectionsResponse, _, err = client.Enterprise.ListCollections(nil)
+	require.NoError(t, err)
+	assert.Empty(t, listCollectionsResponse.Collections)
+}
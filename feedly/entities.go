@@ -0,0 +1,506 @@
+package feedly
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/sfanous/go-feedly/internal/mapstructure"
+)
+
+const (
+	// EntityBatchSize is the maximum number of entities fetched per request by EntityService.Details.
+	EntityBatchSize = 100
+	// EntityAliasLookups is the maximum number of entities looked up one at a time, per batch, by EntityService.Details
+	// to tell the entities returned under their canonical ID apart.
+	EntityAliasLookups = 10
+	// EntityCacheSize is the default maximum number of entities cached by EntityService.
+	EntityCacheSize = 10000
+	// EntityCacheTTL is the default duration for which EntityService caches an entity.
+	EntityCacheTTL = 24 * time.Hour
+)
+
+// EntityCachePolicy configures the cache of the entities resolved by EntityService. A field left to zero takes its
+// default.
+type EntityCachePolicy struct {
+	// Size is the maximum number of entities cached, beyond which the least recently used ones are evicted. Defaults to
+	// EntityCacheSize.
+	Size int
+	// TTL is the duration for which an entity is cached. Defaults to EntityCacheTTL.
+	TTL time.Duration
+}
+
+// WithEntityCachePolicy returns a function that initializes a Client with an entity cache policy.
+func WithEntityCachePolicy(entityCachePolicy *EntityCachePolicy) func(*Client) {
+	return func(c *Client) {
+		c.entityCachePolicy = entityCachePolicy
+	}
+}
+
+// size returns the maximum number of entities cached.
+func (p *EntityCachePolicy) size() int {
+	if p == nil || p.Size < 1 {
+		return EntityCacheSize
+	}
+
+	return p.Size
+}
+
+// ttl returns the duration for which an entity is cached.
+func (p *EntityCachePolicy) ttl() time.Duration {
+	if p == nil || p.TTL <= 0 {
+		return EntityCacheTTL
+	}
+
+	return p.TTL
+}
+
+// EntityService provides methods for resolving the entities and topics, such as "nlp/f/entity/..." and
+// "nlp/f/topic/...", referenced by entries, feeds, and collections. Resolved entities are cached as configured by the
+// EntityCachePolicy of the Client. Entities that cannot be resolved are not cached.
+type EntityService struct {
+	sling  *sling.Sling
+	policy *EntityCachePolicy
+
+	mu sync.Mutex
+	// cache maps the IDs of the entities looked up, as requested and as canonicalized by Feedly, to their elements in
+	// recent, holding entityCacheEntry values.
+	cache map[string]*list.Element
+	// recent orders the cached entities from the most to the least recently used.
+	recent *list.List
+}
+
+// entityCacheEntry is an entity cached under the ID id until expires.
+type entityCacheEntry struct {
+	id      string
+	entity  *Entity
+	expires time.Time
+}
+
+// newEntityService returns a new EntityService.
+func newEntityService(sling *sling.Sling, policy *EntityCachePolicy) *EntityService {
+	return &EntityService{
+		sling:  sling,
+		policy: policy,
+		cache:  make(map[string]*list.Element),
+		recent: list.New(),
+	}
+}
+
+// Entity is a Feedly entity or topic.
+type Entity struct {
+	Description *string `json:"description,omitempty"`
+	ID          *string `json:"id,omitempty"`
+	Label       *string `json:"label,omitempty"`
+	// Links are the pages describing the entity in knowledge bases, such as Wikipedia or Wikidata.
+	Links []EntityLink `json:"links,omitempty"`
+	// Type is the kind of the entity, such as "org", "person", "location", or "topic".
+	Type           *string                `json:"type,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// EntityLink is a page describing an Entity in a knowledge base.
+type EntityLink struct {
+	// Source is the knowledge base, such as "wikipedia" or "wikidata".
+	Source         *string                `json:"source,omitempty"`
+	URL            *string                `json:"url,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// EntityDetailsResponse represents the response from EntityService.Details.
+type EntityDetailsResponse struct {
+	Entities       []Entity               `json:"entities"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Details returns the details of one or more entities, in the order of entityIDs. Entities that cannot be resolved are
+// omitted. Entities not already cached are fetched in batches of EntityBatchSize, and the response to the last batch
+// is returned. When every entity is cached, no request is sent and the response returned is nil.
+func (s *EntityService) Details(entityIDs []string) (*EntityDetailsResponse, *http.Response, error) {
+	return s.DetailsWithContext(context.Background(), entityIDs)
+}
+
+// DetailsWithContext is like Details but uses ctx to control the lifetime of the requests.
+func (s *EntityService) DetailsWithContext(ctx context.Context, entityIDs []string) (*EntityDetailsResponse, *http.Response, error) {
+	resolved, resp, err := s.details(ctx, entityIDs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	decodedResponse := &EntityDetailsResponse{
+		Entities: make([]Entity, 0, len(entityIDs)),
+	}
+
+	for _, entityID := range entityIDs {
+		if entity, ok := resolved[entityID]; ok {
+			decodedResponse.Entities = append(decodedResponse.Entities, *entity.clone())
+		}
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// details resolves the entities entityIDs, from the cache or by fetching them, and returns them by requested ID along
+// with the response to the last request sent, if any.
+func (s *EntityService) details(ctx context.Context, entityIDs []string) (map[string]*Entity, *http.Response, error) {
+	resolved := make(map[string]*Entity, len(entityIDs))
+	missing := make([]string, 0)
+	seen := make(map[string]struct{}, len(entityIDs))
+
+	s.mu.Lock()
+
+	for _, entityID := range entityIDs {
+		if _, ok := seen[entityID]; ok {
+			continue
+		}
+
+		seen[entityID] = struct{}{}
+
+		if entity := s.lookup(entityID); entity != nil {
+			resolved[entityID] = entity
+		} else {
+			missing = append(missing, entityID)
+		}
+	}
+
+	s.mu.Unlock()
+
+	var resp *http.Response
+
+	for start := 0; start < len(missing); start += EntityBatchSize {
+		end := start + EntityBatchSize
+		if end > len(missing) {
+			end = len(missing)
+		}
+
+		var err error
+
+		resp, err = s.fetch(ctx, missing[start:end], resolved)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return resolved, resp, nil
+}
+
+// fetch fetches the entities entityIDs, adds those resolved to resolved under their requested ID, and caches them.
+func (s *EntityService) fetch(ctx context.Context, entityIDs []string, resolved map[string]*Entity) (*http.Response, error) {
+	encodedResponse := make([]map[string]interface{}, 0)
+	entities := make([]Entity, 0, len(entityIDs))
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("entities/.mget").BodyJSON(entityIDs), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &entities); err != nil {
+		return resp, err
+	}
+
+	requested := make(map[string]struct{}, len(entityIDs))
+
+	for _, entityID := range entityIDs {
+		requested[entityID] = struct{}{}
+	}
+
+	// canonical are the entities returned under an ID that was not requested, as Feedly resolves a non-canonical entity
+	// ID to its canonical entity.
+	canonical := make(map[string]*Entity)
+
+	s.mu.Lock()
+
+	for i := range entities {
+		if entities[i].ID == nil {
+			continue
+		}
+
+		s.store(*entities[i].ID, &entities[i])
+
+		if _, ok := requested[*entities[i].ID]; ok {
+			resolved[*entities[i].ID] = &entities[i]
+		} else {
+			canonical[*entities[i].ID] = &entities[i]
+		}
+	}
+
+	s.mu.Unlock()
+
+	unresolved := make([]string, 0)
+
+	for _, entityID := range entityIDs {
+		if _, ok := resolved[entityID]; !ok {
+			unresolved = append(unresolved, entityID)
+		}
+	}
+
+	// The batch response does not say which requested ID a canonical entity answers. Without canonical entities, the
+	// unresolved IDs are unknown. A single unresolved ID can only be answered by a single canonical entity. Otherwise,
+	// the unresolved IDs are looked up one at a time, up to EntityAliasLookups of them, and the remaining ones are left
+	// uncached, to be resolved by a later call.
+	if len(canonical) == 0 {
+		return resp, nil
+	}
+
+	if len(unresolved) == 1 && len(canonical) == 1 {
+		for _, entity := range canonical {
+			s.mu.Lock()
+			s.store(unresolved[0], entity)
+			s.mu.Unlock()
+
+			resolved[unresolved[0]] = entity
+		}
+
+		return resp, nil
+	}
+
+	for i := 0; i < len(unresolved) && i < EntityAliasLookups; i++ {
+		entity, _, err := s.get(ctx, unresolved[i])
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return resp, err
+		}
+
+		s.mu.Lock()
+		s.store(unresolved[i], entity)
+		s.mu.Unlock()
+
+		resolved[unresolved[i]] = entity
+	}
+
+	return resp, nil
+}
+
+// Entity returns the details of an entity. When the entity is cached, no request is sent and the response returned is
+// nil.
+func (s *EntityService) Entity(entityID string) (*Entity, *http.Response, error) {
+	return s.EntityWithContext(context.Background(), entityID)
+}
+
+// EntityWithContext is like Entity but uses ctx to control the lifetime of the request.
+func (s *EntityService) EntityWithContext(ctx context.Context, entityID string) (*Entity, *http.Response, error) {
+	s.mu.Lock()
+	entity := s.lookup(entityID)
+	s.mu.Unlock()
+
+	if entity != nil {
+		return entity.clone(), nil, nil
+	}
+
+	entity, resp, err := s.get(ctx, entityID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	s.mu.Lock()
+	s.store(entityID, entity)
+
+	if entity.ID != nil {
+		s.store(*entity.ID, entity)
+	}
+
+	s.mu.Unlock()
+
+	return entity.clone(), resp, nil
+}
+
+// get fetches the entity entityID, bypassing the cache.
+func (s *EntityService) get(ctx context.Context, entityID string) (*Entity, *http.Response, error) {
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(Entity)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("entities/"+url.PathEscape(entityID)), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, decodedResponse); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// lookup returns the entity cached under entityID, or nil if it is not cached or has expired. s.mu must be held.
+func (s *EntityService) lookup(entityID string) *Entity {
+	element, ok := s.cache[entityID]
+	if !ok {
+		return nil
+	}
+
+	entry := element.Value.(*entityCacheEntry)
+
+	if !time.Now().Before(entry.expires) {
+		s.recent.Remove(element)
+		delete(s.cache, entityID)
+
+		return nil
+	}
+
+	s.recent.MoveToFront(element)
+
+	return entry.entity
+}
+
+// store caches entity under entityID, evicting the least recently used entities beyond the size of the cache. s.mu
+// must be held.
+func (s *EntityService) store(entityID string, entity *Entity) {
+	entry := &entityCacheEntry{
+		id:      entityID,
+		entity:  entity,
+		expires: time.Now().Add(s.policy.ttl()),
+	}
+
+	if element, ok := s.cache[entityID]; ok {
+		element.Value = entry
+		s.recent.MoveToFront(element)
+
+		return
+	}
+
+	s.cache[entityID] = s.recent.PushFront(entry)
+
+	for s.recent.Len() > s.policy.size() {
+		oldest := s.recent.Back()
+		s.recent.Remove(oldest)
+		delete(s.cache, oldest.Value.(*entityCacheEntry).id)
+	}
+}
+
+// clone returns a copy of e, so the entities of the cache cannot be modified through the entities returned.
+func (e *Entity) clone() *Entity {
+	c := *e
+	c.Description = cloneString(e.Description)
+	c.ID = cloneString(e.ID)
+	c.Label = cloneString(e.Label)
+	c.Type = cloneString(e.Type)
+	c.UnmappedFields = cloneFields(e.UnmappedFields)
+
+	if e.Links != nil {
+		c.Links = make([]EntityLink, 0, len(e.Links))
+
+		for _, link := range e.Links {
+			c.Links = append(c.Links, EntityLink{
+				Source:         cloneString(link.Source),
+				URL:            cloneString(link.URL),
+				UnmappedFields: cloneFields(link.UnmappedFields),
+			})
+		}
+	}
+
+	return &c
+}
+
+// cloneString returns a copy of s, or nil if s is nil.
+func cloneString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+
+	return NewString(*s)
+}
+
+// cloneFields returns a shallow copy of fields, or nil if fields is nil.
+func cloneFields(fields map[string]interface{}) map[string]interface{} {
+	if fields == nil {
+		return nil
+	}
+
+	c := make(map[string]interface{}, len(fields))
+
+	for k, v := range fields {
+		c[k] = v
+	}
+
+	return c
+}
+
+// ClearCache forgets every entity looked up so far.
+func (s *EntityService) ClearCache() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache = make(map[string]*list.Element)
+	s.recent = list.New()
+}
+
+// EnrichedEntry is an Entry along with the details of the entities and topics it references.
+type EnrichedEntry struct {
+	Entry
+	// Entities are the resolved entities of Entry.Entities, in the same order, omitting those that cannot be resolved.
+	Entities []Entity
+	// Topics are the resolved topics of Entry.CommonTopics, in the same order, omitting those that cannot be resolved.
+	Topics []Entity
+}
+
+// Enrich resolves the entities and common topics referenced by entries, with as few requests as possible.
+func (s *EntityService) Enrich(entries []Entry) ([]EnrichedEntry, error) {
+	return s.EnrichWithContext(context.Background(), entries)
+}
+
+// EnrichWithContext is like Enrich but uses ctx to control the lifetime of the requests.
+func (s *EntityService) EnrichWithContext(ctx context.Context, entries []Entry) ([]EnrichedEntry, error) {
+	entityIDs := make([]string, 0)
+
+	for _, entry := range entries {
+		for _, entity := range entry.Entities {
+			if entity.ID != nil {
+				entityIDs = append(entityIDs, *entity.ID)
+			}
+		}
+
+		for _, topic := range entry.CommonTopics {
+			if topic.ID != nil {
+				entityIDs = append(entityIDs, *topic.ID)
+			}
+		}
+	}
+
+	// The entities are looked up by their requested ID, as an entity may be returned under its canonical ID instead.
+	resolved, _, err := s.details(ctx, entityIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	enriched := make([]EnrichedEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		enrichedEntry := EnrichedEntry{
+			Entry: entry,
+		}
+
+		for _, entity := range entry.Entities {
+			if entity.ID == nil {
+				continue
+			}
+
+			if details, ok := resolved[*entity.ID]; ok {
+				enrichedEntry.Entities = append(enrichedEntry.Entities, *details.clone())
+			}
+		}
+
+		for _, topic := range entry.CommonTopics {
+			if topic.ID == nil {
+				continue
+			}
+
+			if details, ok := resolved[*topic.ID]; ok {
+				enrichedEntry.Topics = append(enrichedEntry.Topics, *details.clone())
+			}
+		}
+
+		enriched = append(enriched, enrichedEntry)
+	}
+
+	return enriched, nil
+}
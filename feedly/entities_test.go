@@ -0,0 +1,164 @@
+package feedly_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntities(t *testing.T) {
+	server, _ := newTestServer(t, 0)
+
+	transport := &countingTransport{base: server.Server.Client().Transport}
+	client := feedly.NewClient(&http.Client{Transport: transport}, feedly.WithAPIBaseURL(server.URL))
+
+	server.AddEntity(feedly.Entity{
+		ID:    feedly.NewString("nlp/f/entity/acme"),
+		Label: feedly.NewString("Acme"),
+		Links: []feedly.EntityLink{{
+			Source: feedly.NewString("wikipedia"),
+			URL:    feedly.NewString("https://en.wikipedia.org/wiki/Acme_Corporation"),
+		}},
+		Type: feedly.NewString("org"),
+	})
+	server.AddEntity(feedly.Entity{
+		ID:    feedly.NewString("nlp/f/topic/3000"),
+		Label: feedly.NewString("Technology"),
+		Type:  feedly.NewString("topic"),
+	})
+
+	detailsResponse, _, err := client.Entities.Details([]string{"nlp/f/topic/3000", "nlp/f/entity/unknown", "nlp/f/entity/acme"})
+	require.NoError(t, err)
+	require.Len(t, detailsResponse.Entities, 2)
+	assert.Equal(t, "Technology", *detailsResponse.Entities[0].Label)
+	assert.Equal(t, "wikipedia", *detailsResponse.Entities[1].Links[0].Source)
+	assert.Equal(t, 1, transport.requests)
+
+	// Unknown entities are not cached.
+	detailsResponse, resp, err := client.Entities.Details([]string{"nlp/f/entity/unknown", "nlp/f/entity/acme"})
+	require.NoError(t, err)
+	require.Len(t, detailsResponse.Entities, 1)
+	assert.NotNil(t, resp)
+	assert.Equal(t, 2, transport.requests)
+
+	detailsResponse, resp, err = client.Entities.Details([]string{"nlp/f/entity/acme"})
+	require.NoError(t, err)
+	require.Len(t, detailsResponse.Entities, 1)
+	assert.Nil(t, resp)
+	assert.Equal(t, 2, transport.requests)
+
+	entry := feedly.Entry{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"entities": [{"id": "nlp/f/entity/acme"}, {"id": "nlp/f/entity/unknown"}],
+		"commonTopics": [{"id": "nlp/f/topic/3000"}]
+	}`), &entry))
+
+	enriched, err := client.Entities.Enrich([]feedly.Entry{entry})
+	require.NoError(t, err)
+	require.Len(t, enriched, 1)
+	require.Len(t, enriched[0].Entities, 1)
+	assert.Equal(t, "Acme", *enriched[0].Entities[0].Label)
+	require.Len(t, enriched[0].Topics, 1)
+	assert.Equal(t, "Technology", *enriched[0].Topics[0].Label)
+	assert.Equal(t, 3, transport.requests)
+
+	client.Entities.ClearCache()
+
+	entity, _, err := client.Entities.Entity("nlp/f/entity/acme")
+	require.NoError(t, err)
+	assert.Equal(t, "Acme", *entity.Label)
+	assert.Equal(t, 4, transport.requests)
+
+	*entity.Label = "Modified"
+	*entity.Links[0].Source = "modified"
+
+	entity, resp, err = client.Entities.Entity("nlp/f/entity/acme")
+	require.NoError(t, err)
+	assert.Equal(t, "Acme", *entity.Label)
+	assert.Equal(t, "wikipedia", *entity.Links[0].Source)
+	assert.Nil(t, resp)
+	assert.Equal(t, 4, transport.requests)
+
+	_, _, err = client.Entities.Entity("nlp/f/entity/unknown")
+	assert.True(t, errors.Is(err, feedly.ErrNotFound))
+	assert.Equal(t, 5, transport.requests)
+
+	// A single alias is matched to the single canonical entity of the batch response.
+	server.AddEntityAlias("nlp/f/entity/acme-corporation", "nlp/f/entity/acme")
+
+	for i := 0; i < 2; i++ {
+		detailsResponse, _, err = client.Entities.Details([]string{"nlp/f/entity/acme-corporation"})
+		require.NoError(t, err)
+		require.Len(t, detailsResponse.Entities, 1, i)
+		assert.Equal(t, "nlp/f/entity/acme", *detailsResponse.Entities[0].ID, i)
+		assert.Equal(t, 6, transport.requests, i)
+	}
+
+	aliasedEntry := feedly.Entry{}
+	require.NoError(t, json.Unmarshal([]byte(`{"entities": [{"id": "nlp/f/entity/acme-corporation"}]}`), &aliasedEntry))
+
+	enriched, err = client.Entities.Enrich([]feedly.Entry{aliasedEntry})
+	require.NoError(t, err)
+	require.Len(t, enriched[0].Entities, 1)
+	assert.Equal(t, "Acme", *enriched[0].Entities[0].Label)
+	assert.Equal(t, 6, transport.requests)
+
+	// Several aliases are told apart by looking them up one at a time.
+	server.AddEntityAlias("nlp/f/entity/acme-inc", "nlp/f/entity/acme")
+	server.AddEntityAlias("nlp/f/topic/tech", "nlp/f/topic/3000")
+
+	detailsResponse, _, err = client.Entities.Details([]string{"nlp/f/entity/acme-inc", "nlp/f/topic/tech", "nlp/f/entity/unknown"})
+	require.NoError(t, err)
+	require.Len(t, detailsResponse.Entities, 2)
+	assert.Equal(t, "Acme", *detailsResponse.Entities[0].Label)
+	assert.Equal(t, "Technology", *detailsResponse.Entities[1].Label)
+	assert.Equal(t, 10, transport.requests)
+}
+
+func TestEntitiesCachePolicy(t *testing.T) {
+	server, _ := newTestServer(t, 0)
+
+	server.AddEntity(feedly.Entity{
+		ID:    feedly.NewString("nlp/f/entity/acme"),
+		Label: feedly.NewString("Acme"),
+	})
+	server.AddEntity(feedly.Entity{
+		ID:    feedly.NewString("nlp/f/topic/3000"),
+		Label: feedly.NewString("Technology"),
+	})
+
+	transport := &countingTransport{base: server.Server.Client().Transport}
+	client := feedly.NewClient(&http.Client{Transport: transport}, feedly.WithAPIBaseURL(server.URL), feedly.WithEntityCachePolicy(&feedly.EntityCachePolicy{
+		Size: 1,
+	}))
+
+	_, _, err := client.Entities.Details([]string{"nlp/f/entity/acme", "nlp/f/topic/3000"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, transport.requests)
+
+	_, resp, err := client.Entities.Entity("nlp/f/topic/3000")
+	require.NoError(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, 1, transport.requests)
+
+	_, _, err = client.Entities.Entity("nlp/f/entity/acme")
+	require.NoError(t, err)
+	assert.Equal(t, 2, transport.requests)
+
+	transport = &countingTransport{base: server.Server.Client().Transport}
+	client = feedly.NewClient(&http.Client{Transport: transport}, feedly.WithAPIBaseURL(server.URL), feedly.WithEntityCachePolicy(&feedly.EntityCachePolicy{
+		TTL: time.Nanosecond,
+	}))
+
+	for i := 1; i <= 2; i++ {
+		_, _, err = client.Entities.Entity("nlp/f/entity/acme")
+		require.NoError(t, err)
+		assert.Equal(t, i, transport.requests)
+	}
+}
@@ -1,8 +1,10 @@
 package feedly
 
 import (
+	"context"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/dghubble/sling"
 	"github.com/sfanous/go-feedly/internal/mapstructure"
@@ -11,13 +13,15 @@ import (
 
 // EntryService provides methods for managing entries.
 type EntryService struct {
-	sling *sling.Sling
+	sling             *sling.Sling
+	multipleGetPolicy *MultipleGetPolicy
 }
 
 // newEntryService returns a new EntryService.
-func newEntryService(sling *sling.Sling) *EntryService {
+func newEntryService(sling *sling.Sling, multipleGetPolicy *MultipleGetPolicy) *EntryService {
 	return &EntryService{
-		sling: sling,
+		sling:             sling,
+		multipleGetPolicy: multipleGetPolicy,
 	}
 }
 
@@ -38,7 +42,8 @@ type Entry struct {
 		Type           *string                `json:"type,omitempty"`
 		UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
 	} `json:"analysisFeedbackPrompt,omitempty"`
-	Author        *string `json:"author,omitempty"`
+	Annotations   []Annotation `json:"annotations,omitempty"`
+	Author        *string      `json:"author,omitempty"`
 	AuthorDetails *struct {
 		Source         *string                `json:"source,omitempty"`
 		URL            *string                `json:"url,omitempty"`
@@ -116,11 +121,11 @@ type Entry struct {
 		Title          *string                `json:"title,omitempty"`
 		UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
 	} `json:"origin,omitempty"`
-	OriginID    *string                  `json:"originId,omitempty"`
-	Priorities  []map[string]interface{} `json:"priorities,omitempty"`
-	Published   *time.Time               `json:"published,omitempty"`
-	Recrawled   *time.Time               `json:"recrawled,omitempty"`
-	SID         *string                  `json:"sid,omitempty"`
+	OriginID    *string         `json:"originId,omitempty"`
+	Priorities  []EntryPriority `json:"priorities,omitempty"`
+	Published   *time.Time      `json:"published,omitempty"`
+	Recrawled   *time.Time      `json:"recrawled,omitempty"`
+	SID         *string         `json:"sid,omitempty"`
 	SearchTerms *struct {
 		IsComplexFilter *bool                  `json:"isComplexFilter,omitempty"`
 		Parts           []string               `json:"parts,omitempty"`
@@ -172,6 +177,32 @@ type Entry struct {
 	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
 }
 
+// BestURL returns the URL that best identifies the article of the entry, trying in order CanonicalURL, Canonical,
+// Alternate, and OriginID if it is an http or https URL. It returns an empty string if the entry has none.
+func (e *Entry) BestURL() string {
+	if e.CanonicalURL != nil && *e.CanonicalURL != "" {
+		return *e.CanonicalURL
+	}
+
+	for _, link := range e.Canonical {
+		if link.HRef != nil && *link.HRef != "" {
+			return *link.HRef
+		}
+	}
+
+	for _, link := range e.Alternate {
+		if link.HRef != nil && *link.HRef != "" {
+			return *link.HRef
+		}
+	}
+
+	if e.OriginID != nil && (strings.HasPrefix(*e.OriginID, "http://") || strings.HasPrefix(*e.OriginID, "https://")) {
+		return *e.OriginID
+	}
+
+	return ""
+}
+
 // EntryContentResponse represents the response from EntryService.Content.
 type EntryContentResponse struct {
 	Entries        []Entry                `json:"entries"`
@@ -180,12 +211,17 @@ type EntryContentResponse struct {
 
 // Content returns the content of an entry.
 func (s *EntryService) Content(entryID string) (*EntryContentResponse, *http.Response, error) {
+	return s.ContentWithContext(context.Background(), entryID)
+}
+
+// ContentWithContext is like Content but uses ctx to control the lifetime of the request.
+func (s *EntryService) ContentWithContext(ctx context.Context, entryID string) (*EntryContentResponse, *http.Response, error) {
 	encodedResponse := make([]map[string]interface{}, 0)
 	decodedResponse := new(EntryContentResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("entries/"+url.PathEscape(entryID)).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("entries/"+url.PathEscape(entryID)), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -204,11 +240,16 @@ type EntryCreateResponse struct {
 
 // Create creates and tags an entry.
 func (s *EntryService) Create(entry *Entry) (*EntryCreateResponse, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), entry)
+}
+
+// CreateWithContext is like Create but uses ctx to control the lifetime of the request.
+func (s *EntryService) CreateWithContext(ctx context.Context, entry *Entry) (*EntryCreateResponse, *http.Response, error) {
 	entryIDs := new(EntryCreateResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("entries").BodyJSON(entry).Receive(&entryIDs.EntryIDs, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Post("entries").BodyJSON(entry), &entryIDs.EntryIDs, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -218,20 +259,36 @@ func (s *EntryService) Create(entry *Entry) (*EntryCreateResponse, *http.Respons
 // EntryMultipleContentResponse represents the response from EntryService.MultipleContent.
 type EntryMultipleContentResponse struct {
 	Entries        []Entry                `json:"entries"`
+	Failures       []BatchError           `json:"-"`
 	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
 }
 
-// MultipleContent returns the content for one or more entries.
+// MultipleContent returns the content for one or more entries, in the order of entryIDs, dropping duplicate IDs. The
+// entries are requested in concurrent batches, as configured by the MultipleGetPolicy of the Client. Failures lists the
+// batches that failed. An error is returned if every batch failed, or if the context is done, in which case the
+// batches already fetched are discarded.
 func (s *EntryService) MultipleContent(entryIDs []string) (*EntryMultipleContentResponse, *http.Response, error) {
-	encodedResponse := make([]map[string]interface{}, 0)
-	decodedResponse := new(EntryMultipleContentResponse)
-	apiError := new(APIError)
+	return s.MultipleContentWithContext(context.Background(), entryIDs)
+}
+
+// MultipleContentWithContext is like MultipleContent but uses ctx to control the lifetime of the requests.
+func (s *EntryService) MultipleContentWithContext(ctx context.Context, entryIDs []string) (*EntryMultipleContentResponse, *http.Response, error) {
+	encodedResponse, failures, resp, err := multipleGet(ctx, entryIDs, s.multipleGetPolicy.entryBatchSize(), s.multipleGetPolicy.workers(), func(ctx context.Context, ids []string) ([]map[string]interface{}, *http.Response, error) {
+		encodedResponse := make([]map[string]interface{}, 0)
+		apiError := new(APIError)
+
+		resp, err := receive(ctx, s.sling.New().Post("entries/.mget").BodyJSON(ids), &encodedResponse, apiError)
 
-	resp, err := s.sling.New().Post("entries/.mget").BodyJSON(entryIDs).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+		return encodedResponse, resp, relevantError(resp, err, apiError)
+	})
+	if err != nil {
 		return nil, resp, err
 	}
 
+	decodedResponse := &EntryMultipleContentResponse{
+		Failures: failures,
+	}
+
 	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Entries); err != nil {
 		return nil, resp, err
 	}
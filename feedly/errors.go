@@ -1,30 +1,148 @@
 package feedly
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/dghubble/sling"
+)
+
+var (
+	// ErrUnauthorized is matched by an APIError returned for a missing, invalid or expired OAuth2 token.
+	ErrUnauthorized = errors.New("feedly: unauthorized")
+	// ErrNotFound is matched by an APIError returned for an unknown resource.
+	ErrNotFound = errors.New("feedly: not found")
+	// ErrRateLimited is matched by an APIError returned once the API rate limit has been exceeded.
+	ErrRateLimited = errors.New("feedly: rate limited")
+	// ErrProRequired is matched by an APIError returned for a request that requires a Pro or Enterprise account. Feedly
+	// does not report this case with a dedicated status code, so the match is best-effort: see APIError.Is.
+	ErrProRequired = errors.New("feedly: pro account required")
+)
+
+// proRequiredPattern matches the error messages Feedly returns for features restricted to paying accounts.
+var proRequiredPattern = regexp.MustCompile(`(?i)\b(pro|business|enterprise|team)\s+(account|plan|subscription)\b|\bupgrade\b`)
 
 // APIError represents a Feedly API Error response.
 // https://developer.feedly.com/cloud/#client-errors
 type APIError struct {
 	ErrorID      string `json:"errorId"`
 	ErrorMessage string `json:"errorMessage"`
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"-"`
+	// Method is the HTTP method of the request.
+	Method string `json:"-"`
+	// Path is the URL path of the request.
+	Path string `json:"-"`
+	// RequestID is the value of the X-Request-Id response header, if any.
+	RequestID string `json:"-"`
+	// Body is the raw response body when it is not a JSON error document, such as an HTML gateway error page.
+	Body string `json:"-"`
 }
 
 // Error returns the string representation of an APIError.
 func (e APIError) Error() string {
-	return fmt.Sprintf("%s: %s", e.ErrorID, e.ErrorMessage)
+	b := strings.Builder{}
+
+	if e.Method != "" {
+		b.WriteString(e.Method + " " + e.Path + ": ")
+	}
+
+	if e.StatusCode != 0 {
+		b.WriteString(fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)))
+	}
+
+	switch {
+	case e.ErrorID != "" || e.ErrorMessage != "":
+		if e.StatusCode != 0 {
+			b.WriteString(": ")
+		}
+
+		b.WriteString(fmt.Sprintf("%s: %s", e.ErrorID, e.ErrorMessage))
+	case e.Body != "":
+		if e.StatusCode != 0 {
+			b.WriteString(": ")
+		}
+
+		b.WriteString(e.Body)
+	}
+
+	return b.String()
+}
+
+// Is reports whether the APIError matches one of the sentinel errors ErrUnauthorized, ErrNotFound, ErrRateLimited,
+// or ErrProRequired. ErrProRequired is matched by a 402 Payment Required response, or by a 403 Forbidden response
+// whose message asks for a paying account or plan, such as "Enterprise account required" or "Please upgrade". The
+// latter is a best-effort guess based on the message, so a 403 that does not match ErrProRequired may still be caused
+// by a missing subscription.
+func (e APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrProRequired:
+		return e.StatusCode == http.StatusPaymentRequired || (e.StatusCode == http.StatusForbidden && proRequiredPattern.MatchString(e.ErrorMessage))
+	}
+
+	return false
 }
 
 func (e APIError) isEmpty() bool {
-	return e.ErrorID == "" && e.ErrorMessage == ""
+	return e.ErrorID == "" && e.ErrorMessage == "" && e.Body == ""
+}
+
+// apiErrorDecoder decodes unsuccessful responses into an APIError, retaining the raw body when it is not a JSON error
+// document. Successful responses are decoded by the wrapped decoder.
+type apiErrorDecoder struct {
+	decoder sling.ResponseDecoder
+}
+
+// Decode implements the sling.ResponseDecoder interface.
+func (d apiErrorDecoder) Decode(resp *http.Response, v interface{}) error {
+	apiError, ok := v.(*APIError)
+	if !ok {
+		return d.decoder.Decode(resp, v)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(b, apiError); err != nil || (apiError.ErrorID == "" && apiError.ErrorMessage == "") {
+		apiError.Body = strings.TrimSpace(string(b))
+	}
+
+	return nil
 }
 
 // relevantError returns any non-nil HTTP related error (Creating the request, getting the response, or decoding the
-// response) if any. Otherwise return the decoded apiError (nil in case of no error).
-func relevantError(httpError error, apiError *APIError) error {
+// response) if any. Otherwise return the decoded apiError, annotated with the details of resp, if the response was
+// unsuccessful (nil in case of no error).
+func relevantError(resp *http.Response, httpError error, apiError *APIError) error {
 	if httpError != nil {
 		return httpError
 	}
 
+	if resp != nil && (resp.StatusCode < 200 || resp.StatusCode > 299) {
+		apiError.StatusCode = resp.StatusCode
+		apiError.RequestID = resp.Header.Get("X-Request-Id")
+
+		if resp.Request != nil {
+			apiError.Method = resp.Request.Method
+			apiError.Path = resp.Request.URL.Path
+		}
+
+		return apiError
+	}
+
 	if !apiError.isEmpty() {
 		return apiError
 	}
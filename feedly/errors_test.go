@@ -0,0 +1,85 @@
+package feedly_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newErrorClient returns a Client whose requests are all answered with status, contentType, and body.
+func newErrorClient(t *testing.T, status int, contentType string, body string) *feedly.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("X-Request-Id", "request-42")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL))
+}
+
+func TestAPIErrorSentinels(t *testing.T) {
+	sentinels := []error{feedly.ErrUnauthorized, feedly.ErrNotFound, feedly.ErrRateLimited, feedly.ErrProRequired}
+
+	for name, test := range map[string]struct {
+		status   int
+		message  string
+		expected error
+	}{
+		"Unauthorized":           {http.StatusUnauthorized, "token expired", feedly.ErrUnauthorized},
+		"NotFound":               {http.StatusNotFound, "not found", feedly.ErrNotFound},
+		"RateLimited":            {http.StatusTooManyRequests, "API rate limit reached", feedly.ErrRateLimited},
+		"PaymentRequired":        {http.StatusPaymentRequired, "", feedly.ErrProRequired},
+		"EnterpriseRequired":     {http.StatusForbidden, "Enterprise account required", feedly.ErrProRequired},
+		"Upgrade":                {http.StatusForbidden, "Please upgrade to use this feature", feedly.ErrProRequired},
+		"ForbiddenTeamResource":  {http.StatusForbidden, "not a member of this team", nil},
+		"ForbiddenProfileUpdate": {http.StatusForbidden, "cannot update a professional profile", nil},
+		"BadRequest":             {http.StatusBadRequest, "invalid stream id", nil},
+	} {
+		client := newErrorClient(t, test.status, "application/json", `{"errorCode": 0, "errorId": "id", "errorMessage": "`+test.message+`"}`)
+
+		_, _, err := client.Profile.List()
+		require.Error(t, err, name)
+
+		for _, sentinel := range sentinels {
+			assert.Equal(t, sentinel == test.expected, errors.Is(err, sentinel), "%s: %v", name, sentinel)
+		}
+	}
+}
+
+func TestAPIErrorDetails(t *testing.T) {
+	client := newErrorClient(t, http.StatusBadRequest, "application/json", `{"errorId": "ap3int-sv2.2020", "errorMessage": "invalid stream id"}`)
+
+	_, _, err := client.Streams.Content("invalid", nil)
+
+	var apiError *feedly.APIError
+	require.True(t, errors.As(err, &apiError), "%T", err)
+	assert.Equal(t, http.StatusBadRequest, apiError.StatusCode)
+	assert.Equal(t, http.MethodGet, apiError.Method)
+	assert.Equal(t, "/v3/streams/invalid/contents", apiError.Path)
+	assert.Equal(t, "request-42", apiError.RequestID)
+	assert.Equal(t, "ap3int-sv2.2020", apiError.ErrorID)
+	assert.Equal(t, "invalid stream id", apiError.ErrorMessage)
+	assert.Empty(t, apiError.Body)
+	assert.Equal(t, "GET /v3/streams/invalid/contents: 400 Bad Request: ap3int-sv2.2020: invalid stream id", err.Error())
+}
+
+func TestAPIErrorHTMLBody(t *testing.T) {
+	client := newErrorClient(t, http.StatusBadGateway, "text/html", "<html><body>502 Bad Gateway</body></html>\n")
+
+	_, _, err := client.Profile.List()
+
+	var apiError *feedly.APIError
+	require.True(t, errors.As(err, &apiError), "%T", err)
+	assert.Equal(t, http.StatusBadGateway, apiError.StatusCode)
+	assert.Equal(t, "<html><body>502 Bad Gateway</body></html>", apiError.Body)
+	assert.Empty(t, apiError.ErrorID)
+	assert.Equal(t, "GET /v3/profile: 502 Bad Gateway: <html><body>502 Bad Gateway</body></html>", err.Error())
+}
@@ -0,0 +1,133 @@
+package feedly_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/sfanous/go-feedly/feedly/feedlytest"
+	pkgtime "github.com/sfanous/go-feedly/pkg/time"
+)
+
+// testFeedID is the ID of the feed holding the entries added by newTestServer.
+const testFeedID = "feed/https://example.com/rss"
+
+// fakeIndustries are the Leo industries, and their topics, of the account emulated by newFakeServer.
+var fakeIndustries = []struct {
+	label  string
+	topics []string
+}{
+	{"Cybersecurity", []string{"security", "privacy"}},
+	{"Fintech", []string{"finance", "payments"}},
+	{"Robotics", []string{"robotics", "automation"}},
+	{"Space", []string{"space", "astronomy"}},
+}
+
+const (
+	// fakeFeedsPerIndustry is the number of feeds of each of fakeIndustries.
+	fakeFeedsPerIndustry = 32
+	// fakeEntriesPerFeed is the number of entries of each feed of fakeIndustries.
+	fakeEntriesPerFeed = 3
+)
+
+// newFakeServer returns a feedlytest.Server whose account provides enough Leo industries, feeds, and entries to run
+// TestFeedly.
+func newFakeServer() *feedlytest.Server {
+	server := feedlytest.NewServer()
+	crawled := time.Now().Truncate(time.Millisecond)
+
+	for _, industry := range fakeIndustries {
+		host := strings.ToLower(industry.label) + ".example.com"
+		feeds := make([]feedly.Feed, 0, fakeFeedsPerIndustry)
+
+		for i := 1; i <= fakeFeedsPerIndustry; i++ {
+			website := fmt.Sprintf("https://%s/blog-%d", host, i)
+			feed := feedly.Feed{
+				Description: feedly.NewString(fmt.Sprintf("%s news about %s", industry.label, strings.Join(industry.topics, " and "))),
+				ID:          feedly.NewString(feedly.FeedStream(website + "/rss").String()),
+				Language:    feedly.NewString("en"),
+				Subscribers: feedly.NewInt(100 * i),
+				Title:       feedly.NewString(fmt.Sprintf("%s Blog %d", industry.label, i)),
+				Topics:      industry.topics,
+				Website:     feedly.NewString(website),
+			}
+
+			feeds = append(feeds, feed)
+			server.AddFeed(feed)
+
+			for j := 1; j <= fakeEntriesPerFeed; j++ {
+				server.AddEntries(*feed.ID, feedly.Entry{
+					Crawled:    &pkgtime.Time{Time: crawled.Add(-time.Duration(i*fakeEntriesPerFeed+j) * time.Hour)},
+					Engagement: feedly.NewInt((i * j * 7) % 50),
+					Title:      feedly.NewString(fmt.Sprintf("%s Blog %d, post %d about %s", industry.label, i, j, industry.topics[j%len(industry.topics)])),
+				})
+			}
+		}
+
+		server.AddLeoIndustry(feedly.Collection{
+			Feeds:  feeds,
+			Label:  feedly.NewString(industry.label),
+			Topics: industry.topics,
+		})
+	}
+
+	return server
+}
+
+// redirectTransport sends the requests to the feedly API to the server at URL instead, preserving their URL as seen by
+// the wrapping transports, such as a cassette.Recorder.
+type redirectTransport struct {
+	URL       string
+	Transport http.RoundTripper
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target, err := url.Parse(t.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	redirected := req.Clone(req.Context())
+	redirected.URL.Scheme = target.Scheme
+	redirected.URL.Host = target.Host
+	redirected.Host = ""
+
+	return t.Transport.RoundTrip(redirected)
+}
+
+// newTestServer returns a feedlytest.Server, closed when t finishes, along with the IDs of numberOfEntries entries it
+// added to the feed testFeedID.
+func newTestServer(t *testing.T, numberOfEntries int) (*feedlytest.Server, []string) {
+	server := feedlytest.NewServer()
+	t.Cleanup(server.Close)
+
+	entries := make([]feedly.Entry, 0, numberOfEntries)
+
+	for i := 0; i < numberOfEntries; i++ {
+		entries = append(entries, feedly.Entry{
+			Title: feedly.NewString("Entry"),
+		})
+	}
+
+	return server, server.AddEntries(testFeedID, entries...)
+}
+
+// countingTransport counts the requests sent through it.
+type countingTransport struct {
+	base     http.RoundTripper
+	mu       sync.Mutex
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.requests++
+	t.mu.Unlock()
+
+	return t.base.RoundTrip(req)
+}
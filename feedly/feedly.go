@@ -1,10 +1,12 @@
 package feedly
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/dghubble/sling"
+	"github.com/sfanous/go-feedly/pkg/decoders"
 )
 
 const (
@@ -16,23 +18,37 @@ const (
 
 // A Client is a Feedly API client. Its zero value is not a usable Feedly client.
 type Client struct {
-	apiBaseURL     string
-	apiBaseVersion string
-	sling          *sling.Sling
+	apiBaseURL        string
+	apiBaseVersion    string
+	entityCachePolicy *EntityCachePolicy
+	multipleGetPolicy *MultipleGetPolicy
+	rateLimitState    *rateLimitState
+	retryPolicy       *RetryPolicy
+	sling             *sling.Sling
 	// Feedly API Services
+	Alerts          *AlertService
+	Annotations     *AnnotationService
 	Boards          *BoardService
 	Collections     *CollectionService
+	EmailFeeds      *EmailFeedService
+	Entities        *EntityService
+	Enterprise      *EnterpriseService
 	Entries         *EntryService
 	Feeds           *FeedService
 	Library         *LibraryService
 	Markers         *MarkerService
 	Mixes           *MixService
+	MuteFilters     *MuteFilterService
 	OPML            *OPMLService
 	Preferences     *PreferenceService
+	Priorities      *PriorityService
 	Profile         *ProfileService
 	Recommendations *RecommendationService
 	Search          *SearchService
+	Share           *ShareService
 	Streams         *StreamService
+	Subscriptions   *SubscriptionService
+	Tags            *TagService
 }
 
 // WithAPIBaseURL returns a function that initializes a Client with an API base URL.
@@ -54,28 +70,86 @@ func NewClient(httpClient *http.Client, optionalParameters ...func(*Client)) *Cl
 	client := &Client{
 		apiBaseURL:     APIBaseURL,
 		apiBaseVersion: APIBaseVersion,
+		rateLimitState: &rateLimitState{},
 	}
 
 	for _, optionalParameter := range optionalParameters {
 		optionalParameter(client)
 	}
 
-	base := sling.New().Client(httpClient).Base(fmt.Sprintf("%s/%s/", client.apiBaseURL, client.apiBaseVersion))
+	httpClient = wrapTransport(httpClient, func(base http.RoundTripper) http.RoundTripper {
+		return &rateLimitTransport{
+			base:  base,
+			state: client.rateLimitState,
+		}
+	})
+
+	if client.retryPolicy != nil {
+		httpClient = wrapTransport(httpClient, func(base http.RoundTripper) http.RoundTripper {
+			return &retryTransport{
+				base:   base,
+				policy: client.retryPolicy,
+			}
+		})
+	}
+
+	base := sling.New().Client(httpClient).Base(fmt.Sprintf("%s/%s/", client.apiBaseURL, client.apiBaseVersion)).ResponseDecoder(apiErrorDecoder{decoder: decoders.JSONDecoder{}})
 
 	client.sling = base
+	client.Profile = newProfileService(base.New())
+	client.Search = newSearchService(base.New())
+	client.Alerts = newAlertService(base.New())
+	client.Annotations = newAnnotationService(base.New())
 	client.Boards = newBoardService(base.New())
 	client.Collections = newCollectionService(base.New())
-	client.Entries = newEntryService(base.New())
-	client.Feeds = newFeedService(base.New())
+	client.EmailFeeds = newEmailFeedService(base.New())
+	client.Entities = newEntityService(base.New(), client.entityCachePolicy)
+	client.Enterprise = newEnterpriseService(base.New())
+	client.Entries = newEntryService(base.New(), client.multipleGetPolicy)
+	client.Feeds = newFeedService(base.New(), client.multipleGetPolicy, client.Search)
 	client.Library = newLibraryService(base.New())
 	client.Markers = newMarkerService(base.New())
 	client.Mixes = newMixService(base.New())
+	client.MuteFilters = newMuteFilterService(base.New(), client.Profile)
 	client.OPML = newOPMLService(base.New())
 	client.Preferences = newPreferenceService(base.New())
-	client.Profile = newProfileService(base.New())
-	client.Search = newSearchService(base.New())
+	client.Priorities = newPriorityService(base.New())
+	client.Share = newShareService(base.New())
 	client.Recommendations = newRecommendationService(base.New())
 	client.Streams = newStreamService(base.New())
+	client.Subscriptions = newSubscriptionService(base.New())
+	client.Tags = newTagService(base.New())
 
 	return client
 }
+
+// wrapTransport returns a shallow copy of httpClient whose transport is wrapped by wrap. The http.Client provided by the
+// caller is left untouched.
+func wrapTransport(httpClient *http.Client, wrap func(http.RoundTripper) http.RoundTripper) *http.Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	wrappedClient := *httpClient
+
+	base := wrappedClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	wrappedClient.Transport = wrap(base)
+
+	return &wrappedClient
+}
+
+// receive creates a new HTTP request from s bound to ctx, sends it and decodes the response into successV if the request
+// succeeded or into failureV otherwise. Cancelling ctx aborts the request, including while the response body is being
+// decoded.
+func receive(ctx context.Context, s *sling.Sling, successV interface{}, failureV interface{}) (*http.Response, error) {
+	req, err := s.Request()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Do(req.WithContext(ctx), successV, failureV)
+}
@@ -3,18 +3,25 @@ package feedly_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/sfanous/go-feedly/feedly"
+	"github.com/sfanous/go-feedly/feedly/feedlytest"
+	"github.com/sfanous/go-feedly/internal/cassette"
+	"github.com/stretchr/testify/assert"
 	"golang.org/x/oauth2"
 )
 
@@ -30,8 +37,17 @@ func (f *stringSliceFlags) Set(flagValue string) error {
 	return nil
 }
 
+const defaultCassetteFile = "../testdata/cassettes/feedly.json"
+
+var defaultCoverImages = stringSliceFlags{
+	"../testdata/covers/cover.gif",
+	"../testdata/covers/cover.jpg",
+	"../testdata/covers/cover.png",
+}
+
 var apiBaseURL string
 var apiBaseVersion string
+var cassetteFile string
 var client *feedly.Client
 var collectionsToMark []*feedly.Collection
 var controlBoards = make(map[string]*feedly.Board)
@@ -41,19 +57,26 @@ var controlCollectionNames = make([]string, 0)
 var controlCollectionNamesAddedFeeds = make(map[string][]feedly.Feed)
 var coverImages stringSliceFlags
 var doLog bool
+var fake bool
 var entriesToMark []feedly.Entry
 var feedsToMark []feedly.Feed
 var librarySharedCollection string
+var liveUnavailable string
 var oauth2TokenFile string
+var unmappedFieldsUnchecked string
 var oauth2Token *oauth2.Token
 var opml *feedly.OPML
 var now time.Time
 var numberOfCollections int
 var numberOfFeeds int
 var profile *feedly.Profile
+var record bool
+var recording *cassette.Cassette
+var replay bool
 var responseBoards = make(map[string]*feedly.Board)
 var responseCollections = make(map[string]*feedly.Collection)
 var responseStreams = make(map[string]map[string]*feedly.Stream)
+var sleepRand = rand.New(rand.NewSource(time.Now().UnixNano()))
 var topics = []string{}
 
 func prepareTestData() {
@@ -104,17 +127,58 @@ func prepareTestData() {
 		topics = append(topics, topic)
 	}
 
+	sort.Strings(topics)
+
 	responseStreams["board"] = make(map[string]*feedly.Stream)
 	responseStreams["collection"] = make(map[string]*feedly.Stream)
 	responseStreams["feed"] = make(map[string]*feedly.Stream)
 	responseStreams["system"] = make(map[string]*feedly.Stream)
 }
 
+// collectionLabels returns the labels of responseCollections in order, so the requests of the test suite are
+// reproducible when replaying a cassette.
+func collectionLabels() []string {
+	labels := make([]string, 0, len(responseCollections))
+
+	for label := range responseCollections {
+		labels = append(labels, label)
+	}
+
+	sort.Strings(labels)
+
+	return labels
+}
+
+// boardLabels returns the labels of responseBoards in order, so the requests of the test suite are reproducible when
+// replaying a cassette.
+func boardLabels() []string {
+	labels := make([]string, 0, len(responseBoards))
+
+	for label := range responseBoards {
+		labels = append(labels, label)
+	}
+
+	sort.Strings(labels)
+
+	return labels
+}
+
 func sleep() {
-	time.Sleep((time.Second * time.Duration(rand.Intn(3))) + (time.Millisecond * time.Duration(rand.Float64()*1000.0)))
+	// Recorded interactions are replayed instantly, and the fake server does not rate limit.
+	if replay || fake {
+		return
+	}
+
+	// The pauses draw from their own source, so they do not shift the random choices a replaying test reproduces.
+	time.Sleep((time.Second * time.Duration(sleepRand.Intn(3))) + (time.Millisecond * time.Duration(sleepRand.Float64()*1000.0)))
 }
 
 func testUnmappedFields(t *testing.T, v interface{}, namePrefix string) {
+	// Only the real Feedly API can reveal fields the library does not map.
+	if unmappedFieldsUnchecked != "" {
+		return
+	}
+
 	interfaceValue := reflect.ValueOf(v)
 	interfaceType := interfaceValue.Type()
 
@@ -207,6 +271,10 @@ func testUnmappedFields(t *testing.T, v interface{}, namePrefix string) {
 }
 
 func TestFeedly(t *testing.T) {
+	if liveUnavailable != "" {
+		t.Skip(liveUnavailable)
+	}
+
 	t.Run("PreferenceServiceUpdateSet", func(t *testing.T) {
 		testPreferenceServiceUpdateSet(t)
 	})
@@ -247,7 +315,9 @@ func TestFeedly(t *testing.T) {
 	})
 	sleep()
 
-	for collectionLabel, collection := range controlCollections {
+	for _, collectionLabel := range controlCollectionNames {
+		collection := controlCollections[collectionLabel]
+
 		t.Run(fmt.Sprintf("CollectionServiceCreate %s", strings.Title(collectionLabel)), func(t *testing.T) {
 			testCollectionServiceCreate(t, collection)
 		})
@@ -309,7 +379,23 @@ func TestFeedly(t *testing.T) {
 		sleep()
 	}
 
-	for boardLabel, board := range controlBoards {
+	t.Run("SubscriptionServiceList", func(t *testing.T) {
+		testSubscriptionServiceList(t)
+	})
+	sleep()
+
+	for _, collectionLabel := range controlCollectionNames {
+		collectionLabel := collectionLabel
+
+		t.Run(fmt.Sprintf("SubscriptionServiceUpdate %s", strings.Title(collectionLabel)), func(t *testing.T) {
+			testSubscriptionServiceUpdate(t, responseCollections[collectionLabel])
+		})
+		sleep()
+	}
+
+	for _, boardLabel := range controlBoardNames {
+		board := controlBoards[boardLabel]
+
 		t.Run(fmt.Sprintf("BoardServiceCreate %s", strings.Title(boardLabel)), func(t *testing.T) {
 			testBoardServiceCreate(t, board)
 		})
@@ -322,6 +408,11 @@ func TestFeedly(t *testing.T) {
 	})
 	sleep()
 
+	t.Run("TagServiceList", func(t *testing.T) {
+		testTagServiceList(t)
+	})
+	sleep()
+
 	for _, boardLabel := range controlBoardNames {
 		collection := responseCollections[boardLabel]
 		collectionStreamedEntries := responseStreams["collection"][*collection.ID].Items
@@ -552,7 +643,9 @@ func TestFeedly(t *testing.T) {
 	})
 	sleep()
 
-	for collectionLabel, collection := range responseCollections {
+	for _, collectionLabel := range collectionLabels() {
+		collection := responseCollections[collectionLabel]
+
 		t.Run(fmt.Sprintf("CollectionServiceDelete %s", strings.Title(collectionLabel)), func(t *testing.T) {
 			testCollectionServiceDelete(t, collection)
 		})
@@ -560,7 +653,9 @@ func TestFeedly(t *testing.T) {
 		sleep()
 	}
 
-	for boardLabel, board := range responseBoards {
+	for _, boardLabel := range boardLabels() {
+		board := responseBoards[boardLabel]
+
 		t.Run(fmt.Sprintf("BoardServiceDelete %s", strings.Title(boardLabel)), func(t *testing.T) {
 			testBoardServiceDelete(t, board)
 		})
@@ -578,7 +673,9 @@ func TestFeedly(t *testing.T) {
 	})
 	sleep()
 
-	for collectionLabel, collection := range responseCollections {
+	for _, collectionLabel := range collectionLabels() {
+		collection := responseCollections[collectionLabel]
+
 		t.Run(fmt.Sprintf("CollectionServiceDelete %s", strings.Title(collectionLabel)), func(t *testing.T) {
 			testCollectionServiceDelete(t, collection)
 		})
@@ -587,43 +684,208 @@ func TestFeedly(t *testing.T) {
 	}
 }
 
-func TestMain(m *testing.M) {
-	now = time.Now()
-	rand.Seed(now.UnixNano())
+func TestContextCancel(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The profile is cut off while its body is being read, other responses never start.
+		if r.URL.Path == "/v3/profile" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id": "`))
+			w.(http.Flusher).Flush()
+		}
+
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+
+	client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL))
+
+	for name, request := range map[string]func(ctx context.Context) error{
+		"Headers": func(ctx context.Context) error {
+			_, _, err := client.Search.FeedsWithContext(ctx, "golang", nil)
+
+			return err
+		},
+		"Body": func(ctx context.Context) error {
+			_, _, err := client.Profile.ListWithContext(ctx)
+
+			return err
+		},
+	} {
+		request := request
+
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
 
+			start := time.Now()
+			err := request(ctx)
+
+			assert.True(t, errors.Is(err, context.DeadlineExceeded), "%v", err)
+			assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+		})
+	}
+}
+
+func TestMain(m *testing.M) {
 	flag.StringVar(&apiBaseURL, "api_url", feedly.APIBaseURL, fmt.Sprintf("The feedly API base URL. Default: %s", feedly.APIBaseURL))
 	flag.StringVar(&apiBaseVersion, "api_version", feedly.APIBaseVersion, fmt.Sprintf("The feedly API base version. Default: %s", feedly.APIBaseVersion))
+	flag.StringVar(&cassetteFile, "cassette", defaultCassetteFile, fmt.Sprintf("Path to the file recording the HTTP interactions of the test suite. Default: %s", defaultCassetteFile))
 	flag.Var(&coverImages, "cover", "Path to a GIF/JPG/PNG file. Flag can be repeated for multiple files")
 	flag.BoolVar(&doLog, "log", false, "Enable logging while executing tests. Default: Disabled")
+	flag.BoolVar(&fake, "fake", false, "Run the test suite against an in-process feedlytest server instead of the feedly API. Default: Disabled")
 	flag.StringVar(&oauth2TokenFile, "oauth2", "", "Absolute or relative path to a file persisting an OAuth2 token in JSON format")
 	flag.IntVar(&numberOfCollections, "collections", 3, "Number of Collections to create. Default: 3")
 	flag.IntVar(&numberOfFeeds, "feeds", 8, "Number of Feeds to subscribe to per Collection. Default: 8")
+	flag.BoolVar(&record, "record", false, "Record the HTTP interactions of the test suite to the cassette file. Requires an OAuth2 token or -fake. Default: Disabled")
 	flag.Parse()
 
-	var b []byte
+	if len(coverImages) == 0 {
+		coverImages = defaultCoverImages
+	}
 
-	if oauth2AccessToken, ok := os.LookupEnv("FEEDLY_ACCESS_TOKEN"); ok {
-		b = []byte(`{"access_token": "` + oauth2AccessToken + `","token_type": "Bearer"}`)
-	} else {
-		var err error
+	var httpClient *http.Client
+
+	b, err := readOAuth2Token()
+	if err != nil {
+		fmt.Printf("feedly_test: TestMain: %v\n", err)
+
+		os.Exit(1)
+	}
+
+	var fakeServer *feedlytest.Server
+
+	switch {
+	case b != nil || fake:
+		now = time.Now()
+		seed := now.UnixNano()
+		rand.Seed(seed)
+
+		if fake {
+			fakeServer = newFakeServer()
+
+			httpClient = &http.Client{
+				Transport: &redirectTransport{
+					URL:       fakeServer.URL,
+					Transport: fakeServer.Server.Client().Transport,
+				},
+			}
+		} else {
+			if err := json.Unmarshal(b, &oauth2Token); err != nil {
+				fmt.Printf("feedly_test: TestMain: %v\n", err)
+
+				os.Exit(1)
+			}
+
+			httpClient = oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(oauth2Token))
+		}
+
+		if record {
+			recording = cassette.New(seed, now)
+			recording.Metadata["collections"] = strconv.Itoa(numberOfCollections)
+			recording.Metadata["feeds"] = strconv.Itoa(numberOfFeeds)
+
+			if fake {
+				recording.Metadata["server"] = "feedlytest"
+			}
+
+			httpClient.Transport = &cassette.Recorder{
+				Cassette:  recording,
+				Transport: httpClient.Transport,
+			}
+		}
+	case record:
+		fmt.Println("feedly_test: TestMain: recording requires an OAuth2 token or the fake server")
+
+		os.Exit(1)
+	default:
+		replaying, err := cassette.Load(cassetteFile)
+		if os.IsNotExist(err) {
+			liveUnavailable = fmt.Sprintf("neither an OAuth2 token nor a cassette (%s) is available", cassetteFile)
+
+			fmt.Printf("feedly_test: TestMain: skipping TestFeedly, %s\n", liveUnavailable)
+
+			os.Exit(m.Run())
+		}
 
-		b, err = ioutil.ReadFile(oauth2TokenFile)
 		if err != nil {
 			fmt.Printf("feedly_test: TestMain: %v\n", err)
 
 			os.Exit(1)
 		}
+
+		replay = true
+		now = replaying.Now
+		rand.Seed(replaying.Seed)
+
+		if numberOfCollections, err = strconv.Atoi(replaying.Metadata["collections"]); err != nil {
+			fmt.Printf("feedly_test: TestMain: invalid cassette collections: %v\n", err)
+
+			os.Exit(1)
+		}
+
+		if numberOfFeeds, err = strconv.Atoi(replaying.Metadata["feeds"]); err != nil {
+			fmt.Printf("feedly_test: TestMain: invalid cassette feeds: %v\n", err)
+
+			os.Exit(1)
+		}
+
+		if replaying.Metadata["server"] == "feedlytest" {
+			unmappedFieldsUnchecked = "the cassette was recorded against the fake server, which only returns mapped fields"
+		}
+
+		httpClient = &http.Client{
+			Transport: &cassette.Replayer{
+				Cassette: replaying,
+			},
+		}
 	}
 
-	if err := json.Unmarshal(b, &oauth2Token); err != nil {
-		fmt.Printf("feedly_test: TestMain: %v\n", err)
+	if fake {
+		unmappedFieldsUnchecked = "the responses come from the fake server, which only returns mapped fields"
+	}
 
-		os.Exit(1)
+	if unmappedFieldsUnchecked != "" {
+		fmt.Printf("feedly_test: TestMain: not checking unmapped fields, %s\n", unmappedFieldsUnchecked)
 	}
 
-	client = feedly.NewClient(oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(oauth2Token)), feedly.WithAPIBaseURL(apiBaseURL), feedly.WithAPIBaseVersion(apiBaseVersion))
+	client = feedly.NewClient(httpClient, feedly.WithAPIBaseURL(apiBaseURL), feedly.WithAPIBaseVersion(apiBaseVersion))
 
 	prepareTestData()
 
-	os.Exit(m.Run())
+	exitCode := m.Run()
+
+	if fakeServer != nil {
+		fakeServer.Close()
+	}
+
+	if recording != nil {
+		if err := recording.Save(cassetteFile); err != nil {
+			fmt.Printf("feedly_test: TestMain: %v\n", err)
+
+			os.Exit(1)
+		}
+	}
+
+	os.Exit(exitCode)
+}
+
+// readOAuth2Token returns the OAuth2 token persisted in the FEEDLY_ACCESS_TOKEN environment variable or in the
+// oauth2TokenFile file, or nil if neither is set.
+func readOAuth2Token() ([]byte, error) {
+	if oauth2AccessToken, ok := os.LookupEnv("FEEDLY_ACCESS_TOKEN"); ok {
+		return []byte(`{"access_token": "` + oauth2AccessToken + `","token_type": "Bearer"}`), nil
+	}
+
+	if oauth2TokenFile == "" {
+		return nil, nil
+	}
+
+	return ioutil.ReadFile(oauth2TokenFile)
 }
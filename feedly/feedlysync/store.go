@@ -0,0 +1,159 @@
+package feedlysync
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// Checkpoint records how far a Syncer has progressed.
+type Checkpoint struct {
+	// Streams maps the ID of each synced stream to the newerThan watermark of its next pull.
+	Streams map[string]time.Time `json:"streams,omitempty"`
+	// Read is the newerThan watermark of the next MarkerService.LatestRead call.
+	Read time.Time `json:"read"`
+	// Tagged is the newerThan watermark of the next MarkerService.LatestTagged call.
+	Tagged time.Time `json:"tagged"`
+}
+
+// copy returns a deep copy of the Checkpoint.
+func (c *Checkpoint) copy() *Checkpoint {
+	copied := &Checkpoint{
+		Streams: make(map[string]time.Time, len(c.Streams)),
+		Read:    c.Read,
+		Tagged:  c.Tagged,
+	}
+
+	for streamID, watermark := range c.Streams {
+		copied.Streams[streamID] = watermark
+	}
+
+	return copied
+}
+
+// Store persists the local mirror maintained by a Syncer.
+type Store interface {
+	// Checkpoint returns the last saved checkpoint, or an empty Checkpoint if none was saved.
+	Checkpoint(ctx context.Context) (*Checkpoint, error)
+	// SaveCheckpoint saves checkpoint.
+	SaveCheckpoint(ctx context.Context, checkpoint *Checkpoint) error
+	// Entry returns the entry entryID, or nil if it is not in the store.
+	Entry(ctx context.Context, entryID string) (*feedly.Entry, error)
+	// FeedEntryIDs returns the IDs of the stored entries originating from the feed feedID.
+	FeedEntryIDs(ctx context.Context, feedID string) ([]string, error)
+	// TaggedEntryIDs returns the IDs of the stored entries tagged with the tag tagID.
+	TaggedEntryIDs(ctx context.Context, tagID string) ([]string, error)
+	// PutEntries adds or replaces entries.
+	PutEntries(ctx context.Context, entries []feedly.Entry) error
+}
+
+// MemoryStore is an in-memory Store. It is safe for concurrent use.
+type MemoryStore struct {
+	mu         sync.RWMutex
+	checkpoint *Checkpoint
+	entries    map[string]feedly.Entry
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		checkpoint: &Checkpoint{},
+		entries:    make(map[string]feedly.Entry),
+	}
+}
+
+// Checkpoint implements the Store interface.
+func (m *MemoryStore) Checkpoint(ctx context.Context) (*Checkpoint, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.checkpoint.copy(), nil
+}
+
+// SaveCheckpoint implements the Store interface.
+func (m *MemoryStore) SaveCheckpoint(ctx context.Context, checkpoint *Checkpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.checkpoint = checkpoint.copy()
+
+	return nil
+}
+
+// Entry implements the Store interface.
+func (m *MemoryStore) Entry(ctx context.Context, entryID string) (*feedly.Entry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entry, ok := m.entries[entryID]
+	if !ok {
+		return nil, nil
+	}
+
+	return copyEntry(&entry), nil
+}
+
+// Entries returns all the stored entries, sorted by ID.
+func (m *MemoryStore) Entries() []feedly.Entry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entries := make([]feedly.Entry, 0, len(m.entries))
+
+	for _, entry := range m.entries {
+		entries = append(entries, *copyEntry(&entry))
+	}
+
+	sort.Slice(entries, func(i int, j int) bool {
+		return *entries[i].ID < *entries[j].ID
+	})
+
+	return entries
+}
+
+// FeedEntryIDs implements the Store interface.
+func (m *MemoryStore) FeedEntryIDs(ctx context.Context, feedID string) ([]string, error) {
+	return m.entryIDs(func(entry *feedly.Entry) bool {
+		return entry.Origin != nil && entry.Origin.StreamID != nil && *entry.Origin.StreamID == feedID
+	}), nil
+}
+
+// TaggedEntryIDs implements the Store interface.
+func (m *MemoryStore) TaggedEntryIDs(ctx context.Context, tagID string) ([]string, error) {
+	return m.entryIDs(func(entry *feedly.Entry) bool {
+		return hasTag(entry, tagID)
+	}), nil
+}
+
+// PutEntries implements the Store interface.
+func (m *MemoryStore) PutEntries(ctx context.Context, entries []feedly.Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range entries {
+		m.entries[*entries[i].ID] = *copyEntry(&entries[i])
+	}
+
+	return nil
+}
+
+// entryIDs returns the sorted IDs of the stored entries satisfying matches.
+func (m *MemoryStore) entryIDs(matches func(entry *feedly.Entry) bool) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entryIDs := make([]string, 0)
+
+	for entryID, entry := range m.entries {
+		if matches(&entry) {
+			entryIDs = append(entryIDs, entryID)
+		}
+	}
+
+	sort.Strings(entryIDs)
+
+	return entryIDs
+}
@@ -0,0 +1,335 @@
+// Package feedlysync maintains a local mirror of Feedly streams.
+//
+// A Syncer pulls the entries added to a set of streams since its last run, applies the read, unread, and tag changes
+// reported by the markers API, and reports what changed as a ChangeSet:
+//
+//	syncer := feedlysync.NewSyncer(client, feedlysync.NewMemoryStore(), []feedly.StreamID{streamID}, nil)
+//
+//	changes, err := syncer.Sync(ctx)
+//	if err != nil {
+//		...
+//	}
+//
+//	for _, entry := range changes.Added {
+//		...
+//	}
+//
+// The progress of a Syncer is saved to its Store as a Checkpoint once all the changes have been stored, so an
+// interrupted Sync is resumed by the next one.
+package feedlysync
+
+import (
+	"context"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+	pkgtime "github.com/sfanous/go-feedly/pkg/time"
+)
+
+const (
+	// DefaultCount is the default number of entries fetched per request.
+	DefaultCount = 100
+	// DefaultOverlap is the default duration by which watermarks are moved back.
+	DefaultOverlap = time.Second
+)
+
+// ChangeSet describes the changes applied to a Store by Syncer.Sync.
+type ChangeSet struct {
+	// Added are the entries added to the store.
+	Added []feedly.Entry
+	// Updated are the stored entries whose content was updated.
+	Updated []feedly.Entry
+	// Read are the IDs of the stored entries marked as read.
+	Read []string
+	// Unread are the IDs of the stored entries marked as unread.
+	Unread []string
+	// Tagged maps tag IDs to the IDs of the stored entries tagged with them.
+	Tagged map[string][]string
+	// Untagged maps tag IDs to the IDs of the stored entries no longer tagged with them.
+	Untagged map[string][]string
+}
+
+// Empty reports whether the ChangeSet holds no change.
+func (c *ChangeSet) Empty() bool {
+	return len(c.Added) == 0 && len(c.Updated) == 0 && len(c.Read) == 0 && len(c.Unread) == 0 && len(c.Tagged) == 0 && len(c.Untagged) == 0
+}
+
+// SyncerOptionalParams are the optional parameters for NewSyncer.
+type SyncerOptionalParams struct {
+	// Count is the number of entries fetched per request. Defaults to DefaultCount.
+	Count *int
+	// Overlap is the duration by which watermarks are moved back to tolerate clock skew and indexing delays. Entries
+	// seen again are only reported if they changed. Defaults to DefaultOverlap.
+	Overlap *time.Duration
+	// TagIDs are the tags whose membership is fully reconciled on each Sync. The markers API only reports tagged
+	// entries, so untagged entries are only detected for these tags.
+	TagIDs []feedly.StreamID
+}
+
+// Syncer mirrors a set of streams into a Store.
+type Syncer struct {
+	client    *feedly.Client
+	store     Store
+	streamIDs []feedly.StreamID
+	count     int
+	overlap   time.Duration
+	tagIDs    []feedly.StreamID
+}
+
+// NewSyncer returns a new Syncer mirroring the streams streamIDs into store.
+func NewSyncer(client *feedly.Client, store Store, streamIDs []feedly.StreamID, optionalParams *SyncerOptionalParams) *Syncer {
+	if optionalParams == nil {
+		optionalParams = &SyncerOptionalParams{}
+	}
+
+	s := &Syncer{
+		client:    client,
+		store:     store,
+		streamIDs: streamIDs,
+		count:     DefaultCount,
+		overlap:   DefaultOverlap,
+		tagIDs:    optionalParams.TagIDs,
+	}
+
+	if optionalParams.Count != nil {
+		s.count = *optionalParams.Count
+	}
+
+	if optionalParams.Overlap != nil {
+		s.overlap = *optionalParams.Overlap
+	}
+
+	return s
+}
+
+// Sync pulls the changes made since the last Sync, applies them to the store, and returns them.
+func (s *Syncer) Sync(ctx context.Context) (*ChangeSet, error) {
+	checkpoint, err := s.store.Checkpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if checkpoint.Streams == nil {
+		checkpoint.Streams = make(map[string]time.Time)
+	}
+
+	tracker := newChangeTracker(s.store)
+
+	if err := s.applyLatestRead(ctx, tracker, checkpoint); err != nil {
+		return nil, err
+	}
+
+	if err := s.applyLatestTagged(ctx, tracker, checkpoint); err != nil {
+		return nil, err
+	}
+
+	for _, streamID := range s.streamIDs {
+		if err := s.pullStream(ctx, tracker, checkpoint, streamID); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, tagID := range s.tagIDs {
+		if err := s.reconcileTag(ctx, tracker, tagID); err != nil {
+			return nil, err
+		}
+	}
+
+	changes, entries := tracker.changes()
+
+	if len(entries) > 0 {
+		if err := s.store.PutEntries(ctx, entries); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.store.SaveCheckpoint(ctx, checkpoint); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// applyLatestRead applies the read operations made since the read watermark.
+func (s *Syncer) applyLatestRead(ctx context.Context, tracker *changeTracker, checkpoint *Checkpoint) error {
+	latestRead, _, err := s.client.Markers.LatestReadWithContext(ctx, &feedly.MarkerLatestReadOptionalParams{
+		NewerThan: newerThan(checkpoint.Read),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, entryID := range latestRead.Entries {
+		if err := tracker.setUnread(ctx, entryID, false); err != nil {
+			return err
+		}
+	}
+
+	for _, entryID := range latestRead.Unread {
+		if err := tracker.setUnread(ctx, entryID, true); err != nil {
+			return err
+		}
+	}
+
+	for _, feed := range latestRead.Feeds {
+		if feed.ID == nil || feed.AsOf == nil {
+			continue
+		}
+
+		entryIDs, err := s.store.FeedEntryIDs(ctx, *feed.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, entryID := range entryIDs {
+			entry, err := tracker.entry(ctx, entryID)
+			if err != nil {
+				return err
+			}
+
+			if entry.Crawled != nil && entry.Crawled.Time.After(feed.AsOf.Time) {
+				continue
+			}
+
+			if err := tracker.setUnread(ctx, entryID, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Without the time of the latest read operation, the watermark is kept so that no operation is skipped.
+	if latestRead.Updated != nil {
+		checkpoint.Read = s.advance(checkpoint.Read, latestRead.Updated.Time)
+	}
+
+	return nil
+}
+
+// applyLatestTagged applies the tag operations made since the tagged watermark.
+func (s *Syncer) applyLatestTagged(ctx context.Context, tracker *changeTracker, checkpoint *Checkpoint) error {
+	started := time.Now()
+
+	latestTagged, _, err := s.client.Markers.LatestTaggedWithContext(ctx, &feedly.MarkerLatestTaggedOptionalParams{
+		NewerThan: newerThan(checkpoint.Tagged),
+	})
+	if err != nil {
+		return err
+	}
+
+	for tagID, entryIDs := range latestTagged.TaggedEntries {
+		for _, entryID := range entryIDs {
+			if err := tracker.setTagged(ctx, entryID, feedly.Board{ID: feedly.NewString(tagID)}, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	checkpoint.Tagged = s.advance(checkpoint.Tagged, started)
+
+	return nil
+}
+
+// pullStream pulls the entries crawled since the watermark of the stream streamID.
+func (s *Syncer) pullStream(ctx context.Context, tracker *changeTracker, checkpoint *Checkpoint, streamID feedly.StreamID) error {
+	watermark := checkpoint.Streams[streamID.String()]
+	latest := time.Time{}
+
+	iterator := s.client.Streams.IterateWithContext(ctx, streamID, &feedly.StreamContentOptionalParams{
+		Count:     feedly.NewInt(s.count),
+		NewerThan: newerThan(watermark),
+	}, nil)
+
+	for iterator.Next() {
+		entry := iterator.Entry()
+
+		if entry.ID == nil {
+			continue
+		}
+
+		if entry.Crawled != nil && entry.Crawled.Time.After(latest) {
+			latest = entry.Crawled.Time
+		}
+
+		if err := tracker.pull(ctx, entry); err != nil {
+			return err
+		}
+	}
+
+	if err := iterator.Err(); err != nil {
+		return err
+	}
+
+	if !latest.IsZero() {
+		checkpoint.Streams[streamID.String()] = s.advance(watermark, latest)
+	}
+
+	return nil
+}
+
+// reconcileTag compares the membership of the tag tagID with the stored entries.
+func (s *Syncer) reconcileTag(ctx context.Context, tracker *changeTracker, tagID feedly.StreamID) error {
+	remote := make(map[string]bool)
+	optionalParams := &feedly.StreamEntryIDsOptionalParams{
+		Count: feedly.NewInt(s.count),
+	}
+
+	for {
+		entryIDs, _, err := s.client.Streams.EntryIDsWithContext(ctx, tagID, optionalParams)
+		if err != nil {
+			return err
+		}
+
+		for _, entryID := range entryIDs.IDs {
+			remote[entryID] = true
+		}
+
+		if entryIDs.Continuation == nil || *entryIDs.Continuation == "" {
+			break
+		}
+
+		optionalParams.Continuation = entryIDs.Continuation
+	}
+
+	local, err := tracker.taggedEntryIDs(ctx, tagID.String())
+	if err != nil {
+		return err
+	}
+
+	for _, entryID := range local {
+		if !remote[entryID] {
+			if err := tracker.setTagged(ctx, entryID, feedly.Board{ID: feedly.NewString(tagID.String())}, false); err != nil {
+				return err
+			}
+		}
+
+		delete(remote, entryID)
+	}
+
+	for entryID := range remote {
+		if err := tracker.setTagged(ctx, entryID, feedly.Board{ID: feedly.NewString(tagID.String())}, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// advance returns the watermark following watermark once everything up to latest has been seen.
+func (s *Syncer) advance(watermark time.Time, latest time.Time) time.Time {
+	latest = latest.Add(-s.overlap)
+
+	if latest.After(watermark) {
+		return latest
+	}
+
+	return watermark
+}
+
+// newerThan returns watermark as a newerThan parameter, or nil if it is the zero time.
+func newerThan(watermark time.Time) *pkgtime.Time {
+	if watermark.IsZero() {
+		return nil
+	}
+
+	return &pkgtime.Time{Time: watermark}
+}
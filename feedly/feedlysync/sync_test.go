@@ -0,0 +1,183 @@
+package feedlysync_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/sfanous/go-feedly/feedly/feedlysync"
+	"github.com/sfanous/go-feedly/feedly/feedlytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testFeedID = "feed/https://example.com/rss"
+
+func addEntries(server *feedlytest.Server, numberOfEntries int) []string {
+	entries := make([]feedly.Entry, 0, numberOfEntries)
+
+	for i := 0; i < numberOfEntries; i++ {
+		entries = append(entries, feedly.Entry{
+			Title: feedly.NewString("Entry"),
+		})
+	}
+
+	return server.AddEntries(testFeedID, entries...)
+}
+
+// noUpdatedTransport removes the time of the latest read operation from the responses of the markers API.
+type noUpdatedTransport struct {
+	base http.RoundTripper
+}
+
+func (t *noUpdatedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || !strings.HasSuffix(req.URL.Path, "/markers/reads") {
+		return resp, err
+	}
+
+	defer resp.Body.Close()
+
+	body := make(map[string]interface{})
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	delete(body, "updated")
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	resp.ContentLength = int64(len(b))
+	resp.Header.Del("Content-Length")
+
+	return resp, nil
+}
+
+func TestSyncWithoutLatestReadUpdated(t *testing.T) {
+	ctx := context.Background()
+	server := feedlytest.NewServer()
+	defer server.Close()
+
+	httpClient := server.Server.Client()
+	httpClient.Transport = &noUpdatedTransport{base: httpClient.Transport}
+	client := feedly.NewClient(httpClient, feedly.WithAPIBaseURL(server.URL))
+	entryIDs := addEntries(server, 3)
+
+	createResponse, _, err := client.Collections.Create("news", &feedly.CollectionCreateOptionalParams{
+		Feeds: []feedly.Feed{{ID: feedly.NewString(testFeedID)}},
+	})
+	require.NoError(t, err)
+
+	store := feedlysync.NewMemoryStore()
+	syncer := feedlysync.NewSyncer(client, store, []feedly.StreamID{feedly.StreamID(*createResponse.Collections[0].ID)}, nil)
+
+	_, err = syncer.Sync(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Markers.Mark(feedly.MarkAsRead, feedly.Entries, &feedly.MarkerMarkOptionalParams{
+		EntryIDs: entryIDs[:1],
+	})
+	require.NoError(t, err)
+
+	changes, err := syncer.Sync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entryIDs[:1], changes.Read)
+
+	checkpoint, err := store.Checkpoint(ctx)
+	require.NoError(t, err)
+	assert.True(t, checkpoint.Read.IsZero(), checkpoint.Read)
+}
+
+func TestSync(t *testing.T) {
+	ctx := context.Background()
+	server := feedlytest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	entryIDs := addEntries(server, 5)
+
+	createResponse, _, err := client.Collections.Create("news", &feedly.CollectionCreateOptionalParams{
+		Feeds: []feedly.Feed{{ID: feedly.NewString(testFeedID)}},
+	})
+	require.NoError(t, err)
+
+	collectionID := *createResponse.Collections[0].ID
+	savedID := feedly.GlobalSaved(server.UserID).String()
+	store := feedlysync.NewMemoryStore()
+	syncer := feedlysync.NewSyncer(client, store, []feedly.StreamID{feedly.StreamID(collectionID)}, &feedlysync.SyncerOptionalParams{
+		Count:  feedly.NewInt(2),
+		TagIDs: []feedly.StreamID{feedly.StreamID(savedID)},
+	})
+
+	changes, err := syncer.Sync(ctx)
+	require.NoError(t, err)
+	assert.Len(t, changes.Added, 5)
+	assert.Len(t, store.Entries(), 5)
+
+	checkpoint, err := store.Checkpoint(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, checkpoint.Streams, collectionID)
+
+	changes, err = syncer.Sync(ctx)
+	require.NoError(t, err)
+	assert.True(t, changes.Empty(), changes)
+
+	_, err = client.Markers.Mark(feedly.MarkAsRead, feedly.Entries, &feedly.MarkerMarkOptionalParams{
+		EntryIDs: entryIDs[:2],
+	})
+	require.NoError(t, err)
+
+	_, err = client.Markers.Mark(feedly.MarkAsSaved, feedly.Entries, &feedly.MarkerMarkOptionalParams{
+		EntryIDs: entryIDs[4:],
+	})
+	require.NoError(t, err)
+
+	changes, err = syncer.Sync(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, changes.Added)
+	assert.ElementsMatch(t, entryIDs[:2], changes.Read)
+	assert.Equal(t, map[string][]string{savedID: entryIDs[4:]}, changes.Tagged)
+
+	_, err = client.Markers.Mark(feedly.KeepUnread, feedly.Entries, &feedly.MarkerMarkOptionalParams{
+		EntryIDs: entryIDs[:1],
+	})
+	require.NoError(t, err)
+
+	_, err = client.Markers.Mark(feedly.MarkAsUnsaved, feedly.Entries, &feedly.MarkerMarkOptionalParams{
+		EntryIDs: entryIDs[4:],
+	})
+	require.NoError(t, err)
+
+	addedIDs := addEntries(server, 3)
+
+	changes, err = syncer.Sync(ctx)
+	require.NoError(t, err)
+	require.Len(t, changes.Added, 3)
+
+	for i, entry := range changes.Added {
+		assert.Contains(t, addedIDs, *entry.ID, i)
+	}
+
+	assert.Equal(t, entryIDs[:1], changes.Unread)
+	assert.Empty(t, changes.Read)
+	assert.Nil(t, changes.Tagged)
+	assert.Equal(t, map[string][]string{savedID: entryIDs[4:]}, changes.Untagged)
+
+	entry, err := store.Entry(ctx, entryIDs[0])
+	require.NoError(t, err)
+	assert.True(t, *entry.Unread)
+	assert.Len(t, store.Entries(), 8)
+
+	changes, err = syncer.Sync(ctx)
+	require.NoError(t, err)
+	assert.True(t, changes.Empty(), changes)
+}
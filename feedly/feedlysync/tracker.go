@@ -0,0 +1,293 @@
+package feedlysync
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sfanous/go-feedly/feedly"
+	pkgtime "github.com/sfanous/go-feedly/pkg/time"
+)
+
+// entryState is the read and tag state of an entry.
+type entryState struct {
+	unread bool
+	tags   map[string]bool
+}
+
+// stateOf returns the state of entry.
+func stateOf(entry *feedly.Entry) *entryState {
+	state := &entryState{
+		unread: entry.Unread != nil && *entry.Unread,
+		tags:   make(map[string]bool, len(entry.Tags)),
+	}
+
+	for _, tag := range entry.Tags {
+		if tag.ID != nil {
+			state.tags[*tag.ID] = true
+		}
+	}
+
+	return state
+}
+
+// changeTracker holds the entries modified during a Sync along with their state before the Sync, so that each change
+// is reported once no matter how many sources report it.
+type changeTracker struct {
+	store Store
+	// original is the state of each tracked entry in the store, nil for added entries.
+	original map[string]*entryState
+	entries  map[string]*feedly.Entry
+	updated  map[string]bool
+	order    []string
+}
+
+// newChangeTracker returns a new changeTracker loading entries from store.
+func newChangeTracker(store Store) *changeTracker {
+	return &changeTracker{
+		store:    store,
+		original: make(map[string]*entryState),
+		entries:  make(map[string]*feedly.Entry),
+		updated:  make(map[string]bool),
+	}
+}
+
+// entry returns the current version of the entry entryID, or nil if it is neither tracked nor stored.
+func (t *changeTracker) entry(ctx context.Context, entryID string) (*feedly.Entry, error) {
+	if entry, ok := t.entries[entryID]; ok {
+		return entry, nil
+	}
+
+	entry, err := t.store.Entry(ctx, entryID)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+
+	entry = copyEntry(entry)
+
+	t.original[entryID] = stateOf(entry)
+	t.entries[entryID] = entry
+	t.order = append(t.order, entryID)
+
+	return entry, nil
+}
+
+// pull records the latest version of an entry.
+func (t *changeTracker) pull(ctx context.Context, pulled *feedly.Entry) error {
+	entryID := *pulled.ID
+
+	existing, err := t.entry(ctx, entryID)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		t.original[entryID] = nil
+		t.order = append(t.order, entryID)
+	} else if isUpdated(existing, pulled) {
+		t.updated[entryID] = true
+	}
+
+	t.entries[entryID] = copyEntry(pulled)
+
+	return nil
+}
+
+// setUnread sets the read state of the entry entryID, if it is stored.
+func (t *changeTracker) setUnread(ctx context.Context, entryID string, unread bool) error {
+	entry, err := t.entry(ctx, entryID)
+	if err != nil || entry == nil {
+		return err
+	}
+
+	entry.Unread = feedly.NewBool(unread)
+
+	return nil
+}
+
+// setTagged adds tag to or removes it from the entry entryID, if it is stored.
+func (t *changeTracker) setTagged(ctx context.Context, entryID string, tag feedly.Board, tagged bool) error {
+	entry, err := t.entry(ctx, entryID)
+	if err != nil || entry == nil {
+		return err
+	}
+
+	if hasTag(entry, *tag.ID) == tagged {
+		return nil
+	}
+
+	if tagged {
+		entry.Tags = append(entry.Tags, tag)
+
+		return nil
+	}
+
+	tags := make([]feedly.Board, 0, len(entry.Tags))
+
+	for _, t := range entry.Tags {
+		if t.ID == nil || *t.ID != *tag.ID {
+			tags = append(tags, t)
+		}
+	}
+
+	entry.Tags = tags
+
+	return nil
+}
+
+// taggedEntryIDs returns the IDs of the entries currently tagged with the tag tagID.
+func (t *changeTracker) taggedEntryIDs(ctx context.Context, tagID string) ([]string, error) {
+	stored, err := t.store.TaggedEntryIDs(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	entryIDs := make([]string, 0, len(stored))
+
+	for _, entryID := range stored {
+		if entry, ok := t.entries[entryID]; ok && !hasTag(entry, tagID) {
+			continue
+		}
+
+		entryIDs = append(entryIDs, entryID)
+	}
+
+	for _, entryID := range t.order {
+		if hasTag(t.entries[entryID], tagID) && !contains(stored, entryID) {
+			entryIDs = append(entryIDs, entryID)
+		}
+	}
+
+	return entryIDs, nil
+}
+
+// changes returns the changes of the tracked entries and the entries to store.
+func (t *changeTracker) changes() (*ChangeSet, []feedly.Entry) {
+	changes := &ChangeSet{
+		Tagged:   make(map[string][]string),
+		Untagged: make(map[string][]string),
+	}
+	entries := make([]feedly.Entry, 0, len(t.order))
+
+	for _, entryID := range t.order {
+		entry := t.entries[entryID]
+		original := t.original[entryID]
+
+		if original == nil {
+			changes.Added = append(changes.Added, *entry)
+			entries = append(entries, *entry)
+
+			continue
+		}
+
+		changed := t.updated[entryID]
+		if changed {
+			changes.Updated = append(changes.Updated, *entry)
+		}
+
+		current := stateOf(entry)
+
+		if current.unread != original.unread {
+			changed = true
+
+			if current.unread {
+				changes.Unread = append(changes.Unread, entryID)
+			} else {
+				changes.Read = append(changes.Read, entryID)
+			}
+		}
+
+		for _, tagID := range sortedKeys(current.tags) {
+			if !original.tags[tagID] {
+				changed = true
+				changes.Tagged[tagID] = append(changes.Tagged[tagID], entryID)
+			}
+		}
+
+		for _, tagID := range sortedKeys(original.tags) {
+			if !current.tags[tagID] {
+				changed = true
+				changes.Untagged[tagID] = append(changes.Untagged[tagID], entryID)
+			}
+		}
+
+		if changed {
+			entries = append(entries, *entry)
+		}
+	}
+
+	if len(changes.Tagged) == 0 {
+		changes.Tagged = nil
+	}
+
+	if len(changes.Untagged) == 0 {
+		changes.Untagged = nil
+	}
+
+	return changes, entries
+}
+
+// isUpdated reports whether pulled is a newer version of the content of existing.
+func isUpdated(existing *feedly.Entry, pulled *feedly.Entry) bool {
+	return !equalString(existing.Fingerprint, pulled.Fingerprint) ||
+		!equalTime(existing.Updated, pulled.Updated) ||
+		!equalTime(existing.Recrawled, pulled.Recrawled) ||
+		!equalInt(existing.UpdateCount, pulled.UpdateCount)
+}
+
+// hasTag reports whether entry is tagged with the tag tagID.
+func hasTag(entry *feedly.Entry, tagID string) bool {
+	for _, tag := range entry.Tags {
+		if tag.ID != nil && *tag.ID == tagID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// copyEntry returns a copy of entry that can be modified without affecting entry.
+func copyEntry(entry *feedly.Entry) *feedly.Entry {
+	copied := *entry
+
+	if entry.Unread != nil {
+		copied.Unread = feedly.NewBool(*entry.Unread)
+	}
+
+	copied.Tags = append([]feedly.Board(nil), entry.Tags...)
+
+	return &copied
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func equalInt(a *int, b *int) bool {
+	return (a == nil) == (b == nil) && (a == nil || *a == *b)
+}
+
+func equalString(a *string, b *string) bool {
+	return (a == nil) == (b == nil) && (a == nil || *a == *b)
+}
+
+func equalTime(a *pkgtime.Time, b *pkgtime.Time) bool {
+	return (a == nil) == (b == nil) && (a == nil || a.Time.Equal(b.Time))
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
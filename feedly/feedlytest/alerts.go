@@ -0,0 +1,134 @@
+package feedlytest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// handleAlerts emulates the alerts endpoints.
+func (s *Server) handleAlerts(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listAlerts(w)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createAlert(w, r)
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		s.updateAlert(w, r, segments[0])
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		s.deleteAlert(w, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported alerts request")
+	}
+}
+
+func (s *Server) listAlerts(w http.ResponseWriter) {
+	alerts := make([]feedly.Alert, 0, len(s.alerts))
+
+	for _, alert := range s.alerts {
+		alerts = append(alerts, *alert)
+	}
+
+	sort.Slice(alerts, func(i int, j int) bool {
+		return *alerts[i].ID < *alerts[j].ID
+	})
+
+	writeJSON(w, alerts)
+}
+
+func (s *Server) createAlert(w http.ResponseWriter, r *http.Request) {
+	alert := feedly.Alert{}
+
+	if !decodeBody(w, r, &alert) || !validAlert(w, &alert) {
+		return
+	}
+
+	alert.Created = now()
+	alert.ID = feedly.NewString(newID())
+	alert.StreamID = feedly.NewString(feedly.AlertStream(s.UserID, *alert.ID).String())
+	alert.Updated = alert.Created
+
+	s.alerts[*alert.ID] = &alert
+
+	writeJSON(w, alert)
+}
+
+func (s *Server) updateAlert(w http.ResponseWriter, r *http.Request, alertID string) {
+	existing, ok := s.alerts[alertID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "alert not found")
+
+		return
+	}
+
+	alert := feedly.Alert{}
+
+	if !decodeBody(w, r, &alert) || !validAlert(w, &alert) {
+		return
+	}
+
+	alert.Created = existing.Created
+	alert.ID = existing.ID
+	alert.StreamID = existing.StreamID
+	alert.Updated = now()
+
+	s.alerts[alertID] = &alert
+
+	writeJSON(w, alert)
+}
+
+func (s *Server) deleteAlert(w http.ResponseWriter, alertID string) {
+	if _, ok := s.alerts[alertID]; !ok {
+		writeError(w, http.StatusNotFound, "alert not found")
+
+		return
+	}
+
+	delete(s.alerts, alertID)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// validAlert reports whether alert has a label and keywords, writing an error response otherwise.
+func validAlert(w http.ResponseWriter, alert *feedly.Alert) bool {
+	switch {
+	case alert.Label == nil || *alert.Label == "":
+		writeError(w, http.StatusBadRequest, "missing alert label")
+	case len(alert.Keywords) == 0:
+		writeError(w, http.StatusBadRequest, "missing alert keywords")
+	default:
+		return true
+	}
+
+	return false
+}
+
+// streamAlert returns the alert whose stream is streamID.
+func (s *Server) streamAlert(streamID string) (*feedly.Alert, bool) {
+	for _, alert := range s.alerts {
+		if *alert.StreamID == streamID {
+			return alert, true
+		}
+	}
+
+	return nil, false
+}
+
+// alertEntries returns the entries, of any feed, mentioning a keyword of alert.
+func (s *Server) alertEntries(alert *feedly.Alert) []*entry {
+	entries := make([]*entry, 0)
+
+	for _, e := range s.entries {
+		for _, keyword := range alert.Keywords {
+			if mentions(e, strings.ToLower(keyword)) {
+				entries = append(entries, e)
+
+				break
+			}
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,162 @@
+package feedlytest
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// annotationBody is the request body of the annotation endpoints.
+type annotationBody struct {
+	EntryID   *string           `json:"entryId,omitempty"`
+	Highlight *feedly.Highlight `json:"highlight,omitempty"`
+	Note      *feedly.Note      `json:"note,omitempty"`
+}
+
+// handleAnnotations emulates the annotations endpoints.
+func (s *Server) handleAnnotations(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listAnnotations(w, r)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createAnnotation(w, r)
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		s.updateAnnotation(w, r, segments[0])
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		s.deleteAnnotation(w, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported annotations request")
+	}
+}
+
+func (s *Server) listAnnotations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if entryID := query.Get("entryId"); entryID != "" {
+		writeJSON(w, map[string]interface{}{
+			"annotations": s.entryAnnotations(entryID),
+		})
+
+		return
+	}
+
+	entries, ok := s.streamEntries(query.Get("streamId"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "stream not found")
+
+		return
+	}
+
+	annotations := make([]feedly.Annotation, 0)
+
+	for _, e := range entries {
+		annotations = append(annotations, s.entryAnnotations(*e.ID)...)
+	}
+
+	start, end, continuation := pageBounds(len(annotations), query)
+
+	writeJSON(w, map[string]interface{}{
+		"annotations":  annotations[start:end],
+		"continuation": continuation,
+	})
+}
+
+func (s *Server) createAnnotation(w http.ResponseWriter, r *http.Request) {
+	body := annotationBody{}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if body.EntryID == nil {
+		writeError(w, http.StatusBadRequest, "missing entry id")
+
+		return
+	}
+
+	if _, ok := s.entries[*body.EntryID]; !ok {
+		writeError(w, http.StatusNotFound, "entry not found")
+
+		return
+	}
+
+	if body.Highlight == nil && body.Note == nil {
+		writeError(w, http.StatusBadRequest, "missing highlight or note")
+
+		return
+	}
+
+	annotation := &feedly.Annotation{
+		AuthorID:  feedly.NewString(s.UserID),
+		Created:   now(),
+		EntryID:   body.EntryID,
+		Highlight: body.Highlight,
+		ID:        feedly.NewString(newID()),
+		Note:      body.Note,
+	}
+
+	s.annotations[*annotation.ID] = annotation
+
+	writeJSON(w, annotation)
+}
+
+func (s *Server) updateAnnotation(w http.ResponseWriter, r *http.Request, annotationID string) {
+	annotation, ok := s.annotations[annotationID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "annotation not found")
+
+		return
+	}
+
+	body := annotationBody{}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if body.Highlight != nil {
+		annotation.Highlight = body.Highlight
+	}
+
+	if body.Note != nil {
+		annotation.Note = body.Note
+	}
+
+	annotation.Updated = now()
+
+	writeJSON(w, annotation)
+}
+
+func (s *Server) deleteAnnotation(w http.ResponseWriter, annotationID string) {
+	if _, ok := s.annotations[annotationID]; !ok {
+		writeError(w, http.StatusNotFound, "annotation not found")
+
+		return
+	}
+
+	delete(s.annotations, annotationID)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// entryAnnotations returns the annotations of the entry entryID, oldest first.
+func (s *Server) entryAnnotations(entryID string) []feedly.Annotation {
+	annotations := make([]feedly.Annotation, 0)
+
+	for _, annotation := range s.annotations {
+		if *annotation.EntryID == entryID {
+			annotations = append(annotations, *annotation)
+		}
+	}
+
+	sort.Slice(annotations, func(i int, j int) bool {
+		if !annotations[i].Created.Time.Equal(annotations[j].Created.Time) {
+			return annotations[i].Created.Time.Before(annotations[j].Created.Time)
+		}
+
+		return *annotations[i].ID < *annotations[j].ID
+	})
+
+	return annotations
+}
@@ -0,0 +1,127 @@
+package feedlytest
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// tokenLifetime is the lifetime, in seconds, of the access tokens issued by a Server.
+const tokenLifetime = 7 * 24 * 60 * 60
+
+// handleAuth emulates the OAuth2 endpoints. The authorization endpoint approves every request on behalf of the user.
+// https://developer.feedly.com/v3/auth/
+func (s *Server) handleAuth(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 1 && segments[0] == "auth" && r.Method == http.MethodGet:
+		s.authorize(w, r)
+	case len(segments) == 1 && segments[0] == "token" && r.Method == http.MethodPost:
+		s.token(w, r)
+	case len(segments) == 1 && segments[0] == "logout" && r.Method == http.MethodPost:
+		s.logout(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported auth request")
+	}
+}
+
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if query.Get("response_type") != "code" || query.Get("client_id") == "" {
+		writeError(w, http.StatusBadRequest, "invalid authorization request")
+
+		return
+	}
+
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || !redirectURI.IsAbs() {
+		writeError(w, http.StatusBadRequest, "invalid redirect_uri")
+
+		return
+	}
+
+	code := newID()
+	s.authCodes[code] = query.Get("redirect_uri")
+
+	redirectQuery := redirectURI.Query()
+	redirectQuery.Set("code", code)
+	redirectQuery.Set("state", query.Get("state"))
+	redirectURI.RawQuery = redirectQuery.Encode()
+
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("client_id") == "" || r.PostForm.Get("client_secret") == "" {
+		writeError(w, http.StatusBadRequest, "invalid token request")
+
+		return
+	}
+
+	response := map[string]interface{}{
+		"expires_in": tokenLifetime,
+		"id":         s.UserID,
+		"plan":       "standard",
+		"token_type": "Bearer",
+	}
+
+	var refreshToken string
+
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		redirectURI, ok := s.authCodes[r.PostForm.Get("code")]
+		if !ok || redirectURI != r.PostForm.Get("redirect_uri") {
+			writeError(w, http.StatusBadRequest, "invalid authorization code")
+
+			return
+		}
+
+		delete(s.authCodes, r.PostForm.Get("code"))
+
+		refreshToken = newID()
+		s.refreshTokens[refreshToken] = struct{}{}
+
+		response["refresh_token"] = refreshToken
+	case "refresh_token":
+		refreshToken = r.PostForm.Get("refresh_token")
+
+		if _, ok := s.refreshTokens[refreshToken]; !ok {
+			writeError(w, http.StatusUnauthorized, "invalid refresh token")
+
+			return
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "unsupported grant type")
+
+		return
+	}
+
+	accessToken := newID()
+	s.accessTokens[accessToken] = refreshToken
+
+	response["access_token"] = accessToken
+
+	writeJSON(w, response)
+}
+
+// logout revokes the access token of the request along with its refresh token.
+func (s *Server) logout(w http.ResponseWriter, r *http.Request) {
+	accessToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	refreshToken, ok := s.accessTokens[accessToken]
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "invalid access token")
+
+		return
+	}
+
+	for token, refreshedBy := range s.accessTokens {
+		if refreshedBy == refreshToken {
+			delete(s.accessTokens, token)
+		}
+	}
+
+	delete(s.refreshTokens, refreshToken)
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,298 @@
+package feedlytest
+
+import (
+	"net/http"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// handleBoards emulates the boards endpoints.
+// https://developer.feedly.com/v3/boards/
+func (s *Server) handleBoards(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listBoards(w, r)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.saveBoard(w, r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.boardDetails(w, segments[0])
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		s.uploadBoardCover(w, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported boards request")
+	}
+}
+
+// handleTags emulates the tags endpoints.
+// https://developer.feedly.com/v3/tags/
+func (s *Server) handleTags(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listTags(w)
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		s.renameTag(w, r, segments[0])
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		s.tagEntries(w, r, splitIDs(r, 1))
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		s.untagEntries(w, r, splitIDs(r, 1), nil)
+	case len(segments) == 2 && r.Method == http.MethodDelete:
+		s.untagEntries(w, r, splitIDs(r, 1), splitIDs(r, 2))
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported tags request")
+	}
+}
+
+func (s *Server) listBoards(w http.ResponseWriter, r *http.Request) {
+	boards := make([]feedly.Board, 0, len(s.boards))
+	sorted := s.sortedBoards()
+
+	if r.URL.Query().Get("withEnterprise") == "true" {
+		sorted = append(sorted, sortBoards(s.teamBoards)...)
+	}
+
+	for _, board := range sorted {
+		boards = append(boards, *board)
+	}
+
+	writeJSON(w, boards)
+}
+
+func (s *Server) saveBoard(w http.ResponseWriter, r *http.Request) {
+	body := struct {
+		DeleteCover    *bool   `json:"deleteCover,omitempty"`
+		Description    *string `json:"description,omitempty"`
+		ID             *string `json:"id,omitempty"`
+		IsPublic       *bool   `json:"isPublic,omitempty"`
+		Label          *string `json:"label,omitempty"`
+		ShowHighlights *bool   `json:"showHighlights,omitempty"`
+		ShowNotes      *bool   `json:"showNotes,omitempty"`
+	}{}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	board, ok := (*feedly.Board)(nil), false
+	if body.ID != nil {
+		board, ok = s.boards[*body.ID]
+	}
+
+	if !ok {
+		if body.Label == nil || *body.Label == "" {
+			writeError(w, http.StatusBadRequest, "missing board label")
+
+			return
+		}
+
+		id := s.tagID(newID())
+		if body.ID != nil {
+			id = *body.ID
+		}
+
+		board = &feedly.Board{
+			Created:        now(),
+			Customizable:   feedly.NewBool(true),
+			Enterprise:     feedly.NewBool(false),
+			ID:             feedly.NewString(id),
+			IsPublic:       feedly.NewBool(false),
+			ShowHighlights: feedly.NewBool(false),
+			ShowNotes:      feedly.NewBool(false),
+			StreamID:       feedly.NewString(id),
+		}
+
+		s.boards[id] = board
+	}
+
+	if body.Label != nil {
+		board.Label = body.Label
+	}
+
+	if body.Description != nil {
+		board.Description = body.Description
+	}
+
+	if body.IsPublic != nil {
+		board.IsPublic = body.IsPublic
+	}
+
+	if body.ShowHighlights != nil {
+		board.ShowHighlights = body.ShowHighlights
+	}
+
+	if body.ShowNotes != nil {
+		board.ShowNotes = body.ShowNotes
+	}
+
+	if body.DeleteCover != nil && *body.DeleteCover {
+		board.Cover = nil
+	}
+
+	writeJSON(w, []feedly.Board{*board})
+}
+
+func (s *Server) boardDetails(w http.ResponseWriter, boardID string) {
+	board, ok := s.boards[boardID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "board not found")
+
+		return
+	}
+
+	writeJSON(w, []feedly.Board{*board})
+}
+
+func (s *Server) uploadBoardCover(w http.ResponseWriter, boardID string) {
+	board, ok := s.boards[boardID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "board not found")
+
+		return
+	}
+
+	board.Cover = feedly.NewString(s.URL + "/covers/" + newID())
+
+	writeJSON(w, []feedly.Board{*board})
+}
+
+// entryIDsBody is the body of the requests tagging or untagging entries.
+type entryIDsBody struct {
+	EntryID  *string  `json:"entryId,omitempty"`
+	EntryIDs []string `json:"entryIds,omitempty"`
+}
+
+func (b entryIDsBody) ids() []string {
+	if b.EntryID != nil {
+		return append([]string{*b.EntryID}, b.EntryIDs...)
+	}
+
+	return b.EntryIDs
+}
+
+func (s *Server) tagEntries(w http.ResponseWriter, r *http.Request, tagIDs []string) {
+	body := entryIDsBody{}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	for _, entryID := range body.ids() {
+		if _, ok := s.entries[entryID]; !ok {
+			writeError(w, http.StatusNotFound, "entry not found: "+entryID)
+
+			return
+		}
+	}
+
+	for _, entryID := range body.ids() {
+		for _, tagID := range tagIDs {
+			s.tag(entryID, tagID)
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listTags(w http.ResponseWriter) {
+	tags := []feedly.Board{
+		{ID: feedly.NewString(s.tagID("global.read")), Label: feedly.NewString("global.read")},
+		{ID: feedly.NewString(s.tagID("global.saved")), Label: feedly.NewString("global.saved")},
+	}
+
+	for _, board := range s.sortedBoards() {
+		tags = append(tags, *board)
+	}
+
+	writeJSON(w, tags)
+}
+
+func (s *Server) renameTag(w http.ResponseWriter, r *http.Request, tagID string) {
+	body := struct {
+		Label *string `json:"label,omitempty"`
+	}{}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	board, ok := s.boards[tagID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "tag not found")
+
+		return
+	}
+
+	if body.Label == nil || *body.Label == "" {
+		writeError(w, http.StatusBadRequest, "missing tag label")
+
+		return
+	}
+
+	board.Label = body.Label
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// untagEntries removes the tags tagIDs from the entries entryIDs, or from the entries listed in the request body if
+// entryIDs is nil.
+func (s *Server) untagEntries(w http.ResponseWriter, r *http.Request, tagIDs []string, entryIDs []string) {
+	// Without entries, the tags themselves are deleted.
+	if entryIDs == nil && r.ContentLength == 0 {
+		for _, tagID := range tagIDs {
+			delete(s.boards, tagID)
+
+			for _, e := range s.entries {
+				delete(e.tags, tagID)
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+
+		return
+	}
+
+	if entryIDs == nil {
+		body := entryIDsBody{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		entryIDs = body.ids()
+	}
+
+	for _, entryID := range entryIDs {
+		for _, tagID := range tagIDs {
+			s.untag(entryID, tagID)
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// tag tags the entry entryID with the tag tagID.
+func (s *Server) tag(entryID string, tagID string) {
+	e, ok := s.entries[entryID]
+	if !ok {
+		return
+	}
+
+	if _, ok := e.tags[tagID]; ok {
+		return
+	}
+
+	taggedAt := now().Time
+	e.tags[tagID] = taggedAt
+
+	s.tagLog = append(s.tagLog, tagEvent{
+		entryID: entryID,
+		tagID:   tagID,
+		at:      taggedAt,
+	})
+}
+
+// untag removes the tag tagID from the entry entryID.
+func (s *Server) untag(entryID string, tagID string) {
+	if e, ok := s.entries[entryID]; ok {
+		delete(e.tags, tagID)
+	}
+}
@@ -0,0 +1,243 @@
+package feedlytest
+
+import (
+	"net/http"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// handleCollections emulates the collections endpoints.
+// https://developer.feedly.com/v3/collections/
+func (s *Server) handleCollections(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listCollections(w, r)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.saveCollection(w, r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.collectionDetails(w, segments[0])
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		s.deleteCollection(w, segments[0])
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		s.uploadCollectionCover(w, segments[0])
+	case len(segments) == 2 && segments[1] == "feeds" && r.Method == http.MethodPut:
+		s.addCollectionFeeds(w, r, segments[0], false)
+	case len(segments) == 3 && segments[2] == ".mput" && r.Method == http.MethodPost:
+		s.addCollectionFeeds(w, r, segments[0], true)
+	case len(segments) == 3 && segments[2] == ".mdelete" && r.Method == http.MethodDelete:
+		s.deleteCollectionFeeds(w, r, segments[0], nil)
+	case len(segments) == 3 && segments[1] == "feeds" && r.Method == http.MethodDelete:
+		s.deleteCollectionFeeds(w, r, segments[0], []string{segments[2]})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported collections request")
+	}
+}
+
+func (s *Server) listCollections(w http.ResponseWriter, r *http.Request) {
+	withStats := r.URL.Query().Get("withStats") == "true"
+	collections := make([]feedly.Collection, 0, len(s.collections))
+	sorted := s.sortedCollections()
+
+	if r.URL.Query().Get("withEnterprise") == "true" {
+		sorted = append(sorted, sortCollections(s.teamCollections)...)
+	}
+
+	for _, collection := range sorted {
+		c := *collection
+
+		if withStats {
+			c.NumFeeds = feedly.NewInt(len(c.Feeds))
+		}
+
+		collections = append(collections, c)
+	}
+
+	writeJSON(w, collections)
+}
+
+func (s *Server) saveCollection(w http.ResponseWriter, r *http.Request) {
+	body := struct {
+		DeleteCover *bool         `json:"deleteCover,omitempty"`
+		Description *string       `json:"description,omitempty"`
+		Feeds       []feedly.Feed `json:"feeds,omitempty"`
+		ID          *string       `json:"id,omitempty"`
+		Label       *string       `json:"label,omitempty"`
+	}{}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	collection, ok := (*feedly.Collection)(nil), false
+	if body.ID != nil {
+		collection, ok = s.collections[*body.ID]
+	}
+
+	if !ok {
+		if body.Label == nil || *body.Label == "" {
+			writeError(w, http.StatusBadRequest, "missing collection label")
+
+			return
+		}
+
+		id := s.collectionID(newID())
+		if body.ID != nil {
+			id = *body.ID
+		}
+
+		collection = &feedly.Collection{
+			Created:      now(),
+			Customizable: feedly.NewBool(true),
+			Enterprise:   feedly.NewBool(false),
+			Feeds:        []feedly.Feed{},
+			ID:           feedly.NewString(id),
+		}
+
+		s.collections[id] = collection
+	}
+
+	if body.Label != nil {
+		collection.Label = body.Label
+	}
+
+	if body.Description != nil {
+		collection.Description = body.Description
+	}
+
+	if body.DeleteCover != nil && *body.DeleteCover {
+		collection.Cover = nil
+	}
+
+	for _, feed := range body.Feeds {
+		s.subscribe(collection, feed)
+	}
+
+	writeJSON(w, []feedly.Collection{*collection})
+}
+
+func (s *Server) collectionDetails(w http.ResponseWriter, collectionID string) {
+	collection, ok := s.collections[collectionID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "collection not found")
+
+		return
+	}
+
+	writeJSON(w, []feedly.Collection{*collection})
+}
+
+func (s *Server) deleteCollection(w http.ResponseWriter, collectionID string) {
+	if _, ok := s.collections[collectionID]; !ok {
+		writeError(w, http.StatusNotFound, "collection not found")
+
+		return
+	}
+
+	delete(s.collections, collectionID)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) uploadCollectionCover(w http.ResponseWriter, collectionID string) {
+	collection, ok := s.collections[collectionID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "collection not found")
+
+		return
+	}
+
+	collection.Cover = feedly.NewString(s.URL + "/covers/" + newID())
+
+	writeJSON(w, []feedly.Collection{*collection})
+}
+
+func (s *Server) addCollectionFeeds(w http.ResponseWriter, r *http.Request, collectionID string, multiple bool) {
+	collection, ok := s.collections[collectionID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "collection not found")
+
+		return
+	}
+
+	feeds := make([]feedly.Feed, 0)
+
+	if multiple {
+		if !decodeBody(w, r, &feeds) {
+			return
+		}
+	} else {
+		feed := feedly.Feed{}
+
+		if !decodeBody(w, r, &feed) {
+			return
+		}
+
+		feeds = append(feeds, feed)
+	}
+
+	for _, feed := range feeds {
+		if feed.ID == nil {
+			writeError(w, http.StatusBadRequest, "missing feed id")
+
+			return
+		}
+	}
+
+	for _, feed := range feeds {
+		s.subscribe(collection, feed)
+	}
+
+	// Like the API, respond with all the feeds of the collection.
+	writeJSON(w, collection.Feeds)
+}
+
+func (s *Server) deleteCollectionFeeds(w http.ResponseWriter, r *http.Request, collectionID string, feedIDs []string) {
+	collection, ok := s.collections[collectionID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "collection not found")
+
+		return
+	}
+
+	if feedIDs == nil && !decodeBody(w, r, &feedIDs) {
+		return
+	}
+
+	remove := make(map[string]struct{}, len(feedIDs))
+
+	for _, feedID := range feedIDs {
+		remove[feedID] = struct{}{}
+	}
+
+	feeds := make([]feedly.Feed, 0, len(collection.Feeds))
+
+	for _, feed := range collection.Feeds {
+		if _, ok := remove[*feed.ID]; !ok {
+			feeds = append(feeds, feed)
+		}
+	}
+
+	collection.Feeds = feeds
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// subscribe adds feed to collection unless it is already part of it, and returns the subscribed feed.
+func (s *Server) subscribe(collection *feedly.Collection, feed feedly.Feed) *feedly.Feed {
+	registered := s.registerFeed(feed)
+
+	delete(s.uncategorized, *registered.ID)
+
+	for i := range collection.Feeds {
+		if *collection.Feeds[i].ID == *registered.ID {
+			return &collection.Feeds[i]
+		}
+	}
+
+	subscribed := *registered
+	subscribed.Added = now()
+
+	collection.Feeds = append(collection.Feeds, subscribed)
+
+	return &collection.Feeds[len(collection.Feeds)-1]
+}
@@ -0,0 +1,88 @@
+package feedlytest
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// handleEmailFeeds emulates the email feeds endpoints.
+func (s *Server) handleEmailFeeds(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listEmailFeeds(w)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createEmailFeed(w, r)
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		s.deleteEmailFeed(w, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported email feeds request")
+	}
+}
+
+func (s *Server) listEmailFeeds(w http.ResponseWriter) {
+	emailFeeds := make([]feedly.EmailFeed, 0, len(s.emailFeeds))
+
+	for _, emailFeed := range s.emailFeeds {
+		emailFeeds = append(emailFeeds, *emailFeed)
+	}
+
+	sort.Slice(emailFeeds, func(i int, j int) bool {
+		return *emailFeeds[i].ID < *emailFeeds[j].ID
+	})
+
+	writeJSON(w, emailFeeds)
+}
+
+// createEmailFeed creates an email feed and subscribes to its feed, so the newsletters it receives show up in the
+// streams of the user.
+func (s *Server) createEmailFeed(w http.ResponseWriter, r *http.Request) {
+	body := struct {
+		Title *string `json:"title"`
+	}{}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if body.Title == nil || *body.Title == "" {
+		writeError(w, http.StatusBadRequest, "missing email feed title")
+
+		return
+	}
+
+	id := newID()
+	feed := s.registerFeed(feedly.Feed{
+		ID:    feedly.NewString(feedly.FeedStream("https://feedly.com/newsletters/" + id).String()),
+		Title: body.Title,
+	})
+
+	s.uncategorized[*feed.ID] = feed
+
+	emailFeed := feedly.EmailFeed{
+		Created:  now(),
+		Email:    feedly.NewString(id + "@newsletters.feedly.com"),
+		ID:       feedly.NewString(id),
+		StreamID: feed.ID,
+		Title:    body.Title,
+	}
+
+	s.emailFeeds[id] = &emailFeed
+
+	writeJSON(w, emailFeed)
+}
+
+func (s *Server) deleteEmailFeed(w http.ResponseWriter, emailFeedID string) {
+	emailFeed, ok := s.emailFeeds[emailFeedID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "email feed not found")
+
+		return
+	}
+
+	delete(s.emailFeeds, emailFeedID)
+	delete(s.uncategorized, *emailFeed.StreamID)
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,287 @@
+package feedlytest
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// NewEnterpriseServer is like NewServer but emulates an account that is a member, with the admin role, of the team of
+// the enterprise enterpriseName.
+func NewEnterpriseServer(enterpriseName string) *Server {
+	s := NewServer()
+	s.EnterpriseName = enterpriseName
+
+	s.members[s.UserID] = &feedly.TeamMember{
+		Created:  now(),
+		Email:    s.profile.Email,
+		FullName: s.profile.FullName,
+		ID:       feedly.NewString(s.UserID),
+		Role:     feedly.NewString("admin"),
+	}
+
+	return s
+}
+
+// AddTeamMember adds member to the team of the enterprise emulated by the Server. member must have an ID.
+func (s *Server) AddTeamMember(member feedly.TeamMember) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if member.Role == nil {
+		member.Role = feedly.NewString("member")
+	}
+
+	s.members[*member.ID] = &member
+}
+
+// handleEnterprise emulates the enterprise endpoints, which require the Server to emulate an enterprise account.
+func (s *Server) handleEnterprise(w http.ResponseWriter, r *http.Request, segments []string) {
+	if s.EnterpriseName == "" {
+		writeError(w, http.StatusForbidden, "Enterprise account required")
+
+		return
+	}
+
+	switch {
+	case len(segments) == 1 && segments[0] == "collections" && r.Method == http.MethodGet:
+		s.listTeamCollections(w, r)
+	case len(segments) == 1 && segments[0] == "collections" && r.Method == http.MethodPost:
+		s.saveTeamCollection(w, r)
+	case len(segments) == 2 && segments[0] == "collections" && r.Method == http.MethodDelete:
+		s.deleteTeamCollection(w, segments[1])
+	case len(segments) == 1 && segments[0] == "tags" && r.Method == http.MethodGet:
+		s.listTeamBoards(w)
+	case len(segments) == 1 && segments[0] == "tags" && r.Method == http.MethodPost:
+		s.saveTeamBoard(w, r)
+	case len(segments) == 2 && segments[0] == "tags" && r.Method == http.MethodDelete:
+		s.deleteTeamBoard(w, segments[1])
+	case len(segments) == 1 && segments[0] == "members" && r.Method == http.MethodGet:
+		s.listTeamMembers(w)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported enterprise request")
+	}
+}
+
+func (s *Server) listTeamCollections(w http.ResponseWriter, r *http.Request) {
+	withStats := r.URL.Query().Get("withStats") == "true"
+	collections := make([]feedly.Collection, 0, len(s.teamCollections))
+
+	for _, collection := range sortCollections(s.teamCollections) {
+		c := *collection
+
+		if withStats {
+			c.NumFeeds = feedly.NewInt(len(c.Feeds))
+		}
+
+		collections = append(collections, c)
+	}
+
+	writeJSON(w, collections)
+}
+
+func (s *Server) saveTeamCollection(w http.ResponseWriter, r *http.Request) {
+	body := struct {
+		ACL         []feedly.ACLEntry `json:"acl,omitempty"`
+		Description *string           `json:"description,omitempty"`
+		Feeds       []feedly.Feed     `json:"feeds,omitempty"`
+		ID          *string           `json:"id,omitempty"`
+		Label       *string           `json:"label,omitempty"`
+	}{}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	var collection *feedly.Collection
+
+	if body.ID != nil {
+		existing, ok := s.teamCollections[*body.ID]
+		if !ok {
+			writeError(w, http.StatusNotFound, "collection not found")
+
+			return
+		}
+
+		collection = existing
+	} else {
+		if body.Label == nil || *body.Label == "" {
+			writeError(w, http.StatusBadRequest, "missing collection label")
+
+			return
+		}
+
+		id := feedly.EnterpriseCategory(s.EnterpriseName, newID()).String()
+
+		collection = &feedly.Collection{
+			ACL:          s.defaultACL(),
+			Created:      now(),
+			Customizable: feedly.NewBool(true),
+			Enterprise:   feedly.NewBool(true),
+			Feeds:        []feedly.Feed{},
+			ID:           feedly.NewString(id),
+		}
+
+		s.teamCollections[id] = collection
+	}
+
+	if body.Label != nil {
+		collection.Label = body.Label
+	}
+
+	if body.Description != nil {
+		collection.Description = body.Description
+	}
+
+	if body.ACL != nil {
+		collection.ACL = body.ACL
+	}
+
+	for _, feed := range body.Feeds {
+		if feed.ID == nil {
+			writeError(w, http.StatusBadRequest, "missing feed id")
+
+			return
+		}
+
+		s.addTeamFeed(collection, feed)
+	}
+
+	writeJSON(w, []feedly.Collection{*collection})
+}
+
+func (s *Server) deleteTeamCollection(w http.ResponseWriter, collectionID string) {
+	if _, ok := s.teamCollections[collectionID]; !ok {
+		writeError(w, http.StatusNotFound, "collection not found")
+
+		return
+	}
+
+	delete(s.teamCollections, collectionID)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listTeamBoards(w http.ResponseWriter) {
+	boards := make([]feedly.Board, 0, len(s.teamBoards))
+
+	for _, board := range sortBoards(s.teamBoards) {
+		boards = append(boards, *board)
+	}
+
+	writeJSON(w, boards)
+}
+
+func (s *Server) saveTeamBoard(w http.ResponseWriter, r *http.Request) {
+	body := struct {
+		ACL         []feedly.ACLEntry `json:"acl,omitempty"`
+		Description *string           `json:"description,omitempty"`
+		ID          *string           `json:"id,omitempty"`
+		Label       *string           `json:"label,omitempty"`
+	}{}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	var board *feedly.Board
+
+	if body.ID != nil {
+		existing, ok := s.teamBoards[*body.ID]
+		if !ok {
+			writeError(w, http.StatusNotFound, "board not found")
+
+			return
+		}
+
+		board = existing
+	} else {
+		if body.Label == nil || *body.Label == "" {
+			writeError(w, http.StatusBadRequest, "missing board label")
+
+			return
+		}
+
+		id := feedly.EnterpriseTag(s.EnterpriseName, newID()).String()
+
+		board = &feedly.Board{
+			ACL:          s.defaultACL(),
+			Created:      now(),
+			Customizable: feedly.NewBool(true),
+			Enterprise:   feedly.NewBool(true),
+			ID:           feedly.NewString(id),
+			StreamID:     feedly.NewString(id),
+		}
+
+		s.teamBoards[id] = board
+	}
+
+	if body.Label != nil {
+		board.Label = body.Label
+	}
+
+	if body.Description != nil {
+		board.Description = body.Description
+	}
+
+	if body.ACL != nil {
+		board.ACL = body.ACL
+	}
+
+	writeJSON(w, []feedly.Board{*board})
+}
+
+func (s *Server) deleteTeamBoard(w http.ResponseWriter, boardID string) {
+	if _, ok := s.teamBoards[boardID]; !ok {
+		writeError(w, http.StatusNotFound, "board not found")
+
+		return
+	}
+
+	delete(s.teamBoards, boardID)
+
+	for _, e := range s.entries {
+		delete(e.tags, boardID)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listTeamMembers(w http.ResponseWriter) {
+	members := make([]feedly.TeamMember, 0, len(s.members))
+
+	for _, member := range s.members {
+		members = append(members, *member)
+	}
+
+	sort.Slice(members, func(i int, j int) bool {
+		return *members[i].ID < *members[j].ID
+	})
+
+	writeJSON(w, members)
+}
+
+// defaultACL returns the access list of a new team collection or board, granting the whole team write access.
+func (s *Server) defaultACL() []feedly.ACLEntry {
+	return []feedly.ACLEntry{{
+		Scope:  feedly.NewString("write"),
+		Target: feedly.NewString("enterprise/" + s.EnterpriseName + "/group/global.all"),
+	}}
+}
+
+// addTeamFeed adds feed to the team collection unless it is already part of it.
+func (s *Server) addTeamFeed(collection *feedly.Collection, feed feedly.Feed) {
+	registered := s.registerFeed(feed)
+
+	for _, f := range collection.Feeds {
+		if *f.ID == *registered.ID {
+			return
+		}
+	}
+
+	added := *registered
+	added.Added = now()
+
+	collection.Feeds = append(collection.Feeds, added)
+}
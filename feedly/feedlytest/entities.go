@@ -0,0 +1,66 @@
+package feedlytest
+
+import (
+	"net/http"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// AddEntity registers entity with the Server, making it available to EntityService. entity must have an ID.
+func (s *Server) AddEntity(entity feedly.Entity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entities[*entity.ID] = &entity
+}
+
+// AddEntityAlias makes the entity entityID, previously added with AddEntity, resolvable by alias too, as Feedly resolves
+// non-canonical entity IDs to their canonical entity.
+func (s *Server) AddEntityAlias(alias string, entityID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entities[alias] = s.entities[entityID]
+}
+
+// handleEntities emulates the entities endpoints.
+func (s *Server) handleEntities(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 1 && segments[0] == ".mget" && r.Method == http.MethodPost:
+		s.multipleEntities(w, r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.entityDetails(w, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported entities request")
+	}
+}
+
+func (s *Server) entityDetails(w http.ResponseWriter, entityID string) {
+	entity, ok := s.entities[entityID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "entity not found")
+
+		return
+	}
+
+	writeJSON(w, entity)
+}
+
+// multipleEntities returns the entities among the requested ones that are registered.
+func (s *Server) multipleEntities(w http.ResponseWriter, r *http.Request) {
+	entityIDs := make([]string, 0)
+
+	if !decodeBody(w, r, &entityIDs) {
+		return
+	}
+
+	entities := make([]feedly.Entity, 0, len(entityIDs))
+
+	for _, entityID := range entityIDs {
+		if entity, ok := s.entities[entityID]; ok {
+			entities = append(entities, *entity)
+		}
+	}
+
+	writeJSON(w, entities)
+}
@@ -0,0 +1,138 @@
+package feedlytest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+const (
+	// maxMultipleEntryContentIDs is the maximum number of entries requested at once by entries/.mget.
+	maxMultipleEntryContentIDs = 1000
+	// maxMultipleFeedMetadataIDs is the maximum number of feeds requested at once by feeds/.mget.
+	maxMultipleFeedMetadataIDs = 100
+)
+
+// handleEntries emulates the entries endpoints.
+// https://developer.feedly.com/v3/entries/
+func (s *Server) handleEntries(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createEntry(w, r)
+	case len(segments) == 1 && segments[0] == ".mget" && r.Method == http.MethodPost:
+		s.multipleEntryContent(w, r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.entryContent(w, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported entries request")
+	}
+}
+
+// handleFeeds emulates the feeds endpoints.
+// https://developer.feedly.com/v3/feeds/
+func (s *Server) handleFeeds(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 1 && segments[0] == ".mget" && r.Method == http.MethodPost:
+		s.multipleFeedMetadata(w, r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.feedMetadata(w, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported feeds request")
+	}
+}
+
+func (s *Server) createEntry(w http.ResponseWriter, r *http.Request) {
+	e := feedly.Entry{}
+
+	if !decodeBody(w, r, &e) {
+		return
+	}
+
+	e.ID = feedly.NewString(newID())
+	e.Crawled = now()
+
+	s.entries[*e.ID] = &entry{
+		Entry:  e,
+		unread: true,
+		tags:   make(map[string]time.Time),
+	}
+
+	for _, tag := range e.Tags {
+		if tag.ID != nil {
+			s.tag(*e.ID, *tag.ID)
+		}
+	}
+
+	writeJSON(w, []string{*e.ID})
+}
+
+func (s *Server) entryContent(w http.ResponseWriter, entryID string) {
+	e, ok := s.entries[entryID]
+	if !ok {
+		writeJSON(w, []feedly.Entry{})
+
+		return
+	}
+
+	writeJSON(w, []feedly.Entry{s.render(e)})
+}
+
+func (s *Server) multipleEntryContent(w http.ResponseWriter, r *http.Request) {
+	entryIDs := make([]string, 0)
+
+	if !decodeBody(w, r, &entryIDs) {
+		return
+	}
+
+	if len(entryIDs) > maxMultipleEntryContentIDs {
+		writeError(w, http.StatusBadRequest, "too many entry ids")
+
+		return
+	}
+
+	entries := make([]feedly.Entry, 0, len(entryIDs))
+
+	for _, entryID := range entryIDs {
+		if e, ok := s.entries[entryID]; ok {
+			entries = append(entries, s.render(e))
+		}
+	}
+
+	writeJSON(w, entries)
+}
+
+func (s *Server) feedMetadata(w http.ResponseWriter, feedID string) {
+	feed, ok := s.feeds[feedID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "feed not found")
+
+		return
+	}
+
+	writeJSON(w, feed)
+}
+
+func (s *Server) multipleFeedMetadata(w http.ResponseWriter, r *http.Request) {
+	feedIDs := make([]string, 0)
+
+	if !decodeBody(w, r, &feedIDs) {
+		return
+	}
+
+	if len(feedIDs) > maxMultipleFeedMetadataIDs {
+		writeError(w, http.StatusBadRequest, "too many feed ids")
+
+		return
+	}
+
+	feeds := make([]feedly.Feed, 0, len(feedIDs))
+
+	for _, feedID := range feedIDs {
+		if feed, ok := s.feeds[feedID]; ok {
+			feeds = append(feeds, *feed)
+		}
+	}
+
+	writeJSON(w, feeds)
+}
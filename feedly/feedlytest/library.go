@@ -0,0 +1,161 @@
+package feedlytest
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// sharedResource is the sharing scope of a collection shared through the library.
+type sharedResource struct {
+	Scope  string `json:"scope"`
+	Target string `json:"target"`
+}
+
+// AddLeoIndustry adds collection to the Leo industries listed by LibraryService.LeoIndustries, registering its feeds.
+// collection must have a label.
+func (s *Server) AddLeoIndustry(collection feedly.Collection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if collection.ID == nil {
+		collection.ID = feedly.NewString("leo/industry/" + *collection.Label)
+	}
+
+	feeds := make([]feedly.Feed, 0, len(collection.Feeds))
+
+	for _, feed := range collection.Feeds {
+		feeds = append(feeds, *s.registerFeed(feed))
+	}
+
+	collection.Feeds = feeds
+
+	s.leoIndustries = append(s.leoIndustries, collection)
+}
+
+// handleAlias emulates the alias endpoint of the library.
+func (s *Server) handleAlias(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) != 1 || r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "unsupported alias request")
+
+		return
+	}
+
+	writeJSON(w, feedly.LibraryAliasAvailableResponse{
+		Available: feedly.NewBool(s.cover == nil || s.cover.Alias == nil || *s.cover.Alias != segments[0]),
+	})
+}
+
+// handleLibrary emulates the library endpoints.
+func (s *Server) handleLibrary(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 1 && segments[0] == "cover" && r.Method == http.MethodGet:
+		s.libraryCover(w)
+	case len(segments) == 1 && segments[0] == "cover" && r.Method == http.MethodPost:
+		s.updateLibraryCover(w, r)
+	case len(segments) == 1 && segments[0] == "cover" && r.Method == http.MethodDelete:
+		s.cover = nil
+		s.sharedResources = make(map[string]sharedResource)
+	case len(segments) == 1 && segments[0] == "leoIndustries" && r.Method == http.MethodGet:
+		writeJSON(w, feedly.LibraryLeoIndustriesResponse{
+			Collections: s.leoIndustries,
+		})
+	case len(segments) == 1 && segments[0] == "acl" && r.Method == http.MethodGet:
+		writeJSON(w, s.sharedResources)
+	case len(segments) == 3 && segments[0] == "acl" && r.Method == http.MethodGet:
+		s.shareResource(w, r, segments[1], segments[2])
+	case len(segments) == 3 && segments[0] == "acl" && r.Method == http.MethodDelete:
+		s.unshareResource(w, segments[1])
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.libraryDetails(w, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported library request")
+	}
+}
+
+func (s *Server) libraryCover(w http.ResponseWriter) {
+	if s.cover == nil {
+		writeError(w, http.StatusNotFound, "library not found")
+
+		return
+	}
+
+	writeJSON(w, s.cover)
+}
+
+func (s *Server) updateLibraryCover(w http.ResponseWriter, r *http.Request) {
+	cover := feedly.Cover{}
+
+	if !decodeBody(w, r, &cover) {
+		return
+	}
+
+	if cover.Alias == nil {
+		writeError(w, http.StatusBadRequest, "missing alias")
+
+		return
+	}
+
+	s.cover = &cover
+
+	writeJSON(w, s.cover)
+}
+
+func (s *Server) shareResource(w http.ResponseWriter, r *http.Request, collectionID string, target string) {
+	if _, ok := s.collections[collectionID]; !ok {
+		writeError(w, http.StatusNotFound, "collection not found")
+
+		return
+	}
+
+	resource := sharedResource{
+		Scope:  "view",
+		Target: target,
+	}
+
+	if r.ContentLength != 0 && !decodeBody(w, r, &resource) {
+		return
+	}
+
+	s.sharedResources[collectionID] = resource
+}
+
+func (s *Server) unshareResource(w http.ResponseWriter, collectionID string) {
+	if _, ok := s.sharedResources[collectionID]; !ok {
+		writeError(w, http.StatusNotFound, "resource not shared")
+
+		return
+	}
+
+	delete(s.sharedResources, collectionID)
+}
+
+func (s *Server) libraryDetails(w http.ResponseWriter, alias string) {
+	if s.cover == nil || s.cover.Alias == nil || *s.cover.Alias != alias {
+		writeError(w, http.StatusNotFound, "library not found")
+
+		return
+	}
+
+	collectionIDs := make([]string, 0, len(s.sharedResources))
+
+	for collectionID := range s.sharedResources {
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+
+	sort.Strings(collectionIDs)
+
+	library := feedly.Library{
+		Collections: make([]feedly.Collection, 0, len(collectionIDs)),
+		Cover:       s.cover,
+	}
+
+	for _, collectionID := range collectionIDs {
+		if collection, ok := s.collections[collectionID]; ok {
+			library.Collections = append(library.Collections, *collection)
+		}
+	}
+
+	writeJSON(w, library)
+}
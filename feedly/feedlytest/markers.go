@@ -0,0 +1,256 @@
+package feedlytest
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+	pkgtime "github.com/sfanous/go-feedly/pkg/time"
+)
+
+// handleMarkers emulates the markers endpoints.
+// https://developer.feedly.com/v3/markers/
+func (s *Server) handleMarkers(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.mark(w, r)
+	case len(segments) == 1 && segments[0] == "counts" && r.Method == http.MethodGet:
+		s.unreadCounts(w)
+	case len(segments) == 1 && segments[0] == "reads" && r.Method == http.MethodGet:
+		s.latestRead(w, r)
+	case len(segments) == 1 && segments[0] == "tags" && r.Method == http.MethodGet:
+		s.latestTagged(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported markers request")
+	}
+}
+
+func (s *Server) mark(w http.ResponseWriter, r *http.Request) {
+	body := struct {
+		Action          feedly.MarkAction `json:"action"`
+		AsOf            *pkgtime.Time     `json:"asOf,omitempty"`
+		CollectionIDs   []string          `json:"categoryIds,omitempty"`
+		EntryIDs        []string          `json:"entryIds,omitempty"`
+		FeedIDs         []string          `json:"feedIds,omitempty"`
+		LastReadEntryID *string           `json:"lastReadEntryId,omitempty"`
+		TagIDs          []string          `json:"tagIds,omitempty"`
+		Type            feedly.MarkType   `json:"type"`
+	}{}
+
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	var streamIDs []string
+
+	switch body.Type {
+	case feedly.Entries:
+		switch body.Action {
+		case feedly.MarkAsRead:
+			s.setUnread(body.EntryIDs, false)
+		case feedly.KeepUnread:
+			s.setUnread(body.EntryIDs, true)
+		case feedly.MarkAsSaved:
+			for _, entryID := range body.EntryIDs {
+				s.tag(entryID, s.tagID("global.saved"))
+			}
+		case feedly.MarkAsUnsaved:
+			for _, entryID := range body.EntryIDs {
+				s.untag(entryID, s.tagID("global.saved"))
+			}
+		default:
+			writeError(w, http.StatusBadRequest, "unsupported action for entries: "+string(body.Action))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+
+		return
+	case feedly.Feeds:
+		streamIDs = body.FeedIDs
+	case feedly.Collections:
+		streamIDs = body.CollectionIDs
+	case feedly.Tags:
+		streamIDs = body.TagIDs
+	default:
+		writeError(w, http.StatusBadRequest, "unsupported type: "+string(body.Type))
+
+		return
+	}
+
+	switch body.Action {
+	case feedly.MarkAsRead:
+		asOf := time.Now()
+		if body.AsOf != nil {
+			asOf = body.AsOf.Time
+		}
+
+		for _, streamID := range streamIDs {
+			entries, ok := s.streamEntries(streamID)
+			if !ok {
+				writeError(w, http.StatusNotFound, "stream not found: "+streamID)
+
+				return
+			}
+
+			marked := make([]string, 0, len(entries))
+
+			for _, e := range entries {
+				if e.unread && !e.Crawled.Time.After(asOf) {
+					marked = append(marked, *e.ID)
+				}
+			}
+
+			s.setUnread(marked, false)
+			s.undo[streamID] = marked
+		}
+	case feedly.UndoMarkAsRead:
+		for _, streamID := range streamIDs {
+			s.setUnread(s.undo[streamID], true)
+			delete(s.undo, streamID)
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "unsupported action for "+string(body.Type)+": "+string(body.Action))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// setUnread updates the read state of the entries entryIDs.
+func (s *Server) setUnread(entryIDs []string, unread bool) {
+	at := time.Now()
+
+	for _, entryID := range entryIDs {
+		e, ok := s.entries[entryID]
+		if !ok || e.unread == unread {
+			continue
+		}
+
+		e.unread = unread
+
+		s.readLog = append(s.readLog, readEvent{
+			entryID: entryID,
+			unread:  unread,
+			at:      at,
+		})
+	}
+}
+
+func (s *Server) unreadCounts(w http.ResponseWriter) {
+	type unreadCount struct {
+		Count   int           `json:"count"`
+		ID      string        `json:"id"`
+		Updated *pkgtime.Time `json:"updated"`
+	}
+
+	counts := make([]unreadCount, 0)
+	updated := now()
+
+	countStream := func(streamID string) {
+		entries, _ := s.streamEntries(streamID)
+		count := 0
+
+		for _, e := range entries {
+			if e.unread {
+				count++
+			}
+		}
+
+		counts = append(counts, unreadCount{
+			Count:   count,
+			ID:      streamID,
+			Updated: updated,
+		})
+	}
+
+	countStream(s.collectionID("global.all"))
+
+	for _, collection := range s.sortedCollections() {
+		countStream(*collection.ID)
+	}
+
+	for _, feedID := range s.subscribedFeedIDs() {
+		countStream(feedID)
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"unreadcounts": counts,
+		"updated":      updated,
+	})
+}
+
+func (s *Server) latestRead(w http.ResponseWriter, r *http.Request) {
+	newerThan := newerThanParam(r.URL.Query())
+	read := make([]string, 0)
+	unread := make([]string, 0)
+	seen := make(map[string]struct{})
+
+	// Report the latest state of each entry changed since newerThan.
+	for i := len(s.readLog) - 1; i >= 0; i-- {
+		event := s.readLog[i]
+
+		if !event.at.After(newerThan) {
+			break
+		}
+
+		if _, ok := seen[event.entryID]; ok {
+			continue
+		}
+
+		seen[event.entryID] = struct{}{}
+
+		if event.unread {
+			unread = append(unread, event.entryID)
+		} else {
+			read = append(read, event.entryID)
+		}
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"entries": read,
+		"unread":  unread,
+		"updated": now(),
+	})
+}
+
+func (s *Server) latestTagged(w http.ResponseWriter, r *http.Request) {
+	newerThan := newerThanParam(r.URL.Query())
+	taggedEntries := make(map[string][]string)
+
+	for _, event := range s.tagLog {
+		if !event.at.After(newerThan) {
+			continue
+		}
+
+		e, ok := s.entries[event.entryID]
+		if !ok {
+			continue
+		}
+
+		// Skip tags that have since been removed.
+		if taggedAt, ok := e.tags[event.tagID]; !ok || !taggedAt.Equal(event.at) {
+			continue
+		}
+
+		taggedEntries[event.tagID] = append(taggedEntries[event.tagID], event.entryID)
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"taggedEntries": taggedEntries,
+	})
+}
+
+// newerThanParam returns the value of the newerThan query parameter, or the zero time if it is absent.
+func newerThanParam(query url.Values) time.Time {
+	ms, err := strconv.ParseInt(query.Get("newerThan"), 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return pkgtime.FromUnixMilli(ms)
+}
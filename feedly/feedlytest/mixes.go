@@ -0,0 +1,89 @@
+package feedlytest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// handleMixes emulates the mixes endpoint, ranking the entries of a stream by engagement.
+// https://developer.feedly.com/v3/mixes/
+func (s *Server) handleMixes(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) != 2 || segments[1] != "contents" || r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "unsupported mixes request")
+
+		return
+	}
+
+	entries, ok := s.streamEntries(segments[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "stream not found")
+
+		return
+	}
+
+	query := r.URL.Query()
+	entries = filterEntries(entries, query)
+
+	count := defaultCount
+	if c, err := strconv.Atoi(query.Get("count")); err == nil && c > 0 {
+		count = c
+	}
+
+	hours := 0
+	if h, err := strconv.Atoi(query.Get("hours")); err == nil && h > 0 {
+		hours = h
+	}
+
+	sort.SliceStable(entries, func(i int, j int) bool {
+		return engagement(entries[i]) > engagement(entries[j])
+	})
+
+	mix := make([]*entry, 0, count)
+	backfill := make([]*entry, 0)
+	since := time.Now().Add(-time.Duration(hours) * time.Hour)
+
+	for _, e := range entries {
+		if hours > 0 && e.Crawled.Time.Before(since) {
+			backfill = append(backfill, e)
+
+			continue
+		}
+
+		mix = append(mix, e)
+	}
+
+	// Backfilling completes the mix with older entries when too few entries were crawled in the last hours.
+	if query.Get("backfill") == "true" {
+		mix = append(mix, backfill...)
+	}
+
+	if len(mix) > count {
+		mix = mix[:count]
+	}
+
+	items := make([]feedly.Entry, 0, len(mix))
+
+	for _, e := range mix {
+		items = append(items, s.render(e))
+	}
+
+	writeJSON(w, feedly.Stream{
+		ID:      feedly.NewString(segments[0]),
+		Items:   items,
+		Title:   s.streamTitle(segments[0]),
+		Updated: now(),
+	})
+}
+
+// engagement returns the engagement of e, or 0 if it has none.
+func engagement(e *entry) int {
+	if e.Engagement == nil {
+		return 0
+	}
+
+	return *e.Engagement
+}
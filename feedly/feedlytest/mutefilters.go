@@ -0,0 +1,105 @@
+package feedlytest
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// handleMuteFilters emulates the mute filters endpoints.
+func (s *Server) handleMuteFilters(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listMuteFilters(w)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createMuteFilter(w, r)
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		s.updateMuteFilter(w, r, segments[0])
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		s.deleteMuteFilter(w, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported mute filters request")
+	}
+}
+
+func (s *Server) listMuteFilters(w http.ResponseWriter) {
+	muteFilters := make([]feedly.MuteFilter, 0, len(s.muteFilters))
+
+	for _, muteFilter := range s.muteFilters {
+		muteFilters = append(muteFilters, *muteFilter)
+	}
+
+	sort.Slice(muteFilters, func(i int, j int) bool {
+		return *muteFilters[i].ID < *muteFilters[j].ID
+	})
+
+	writeJSON(w, muteFilters)
+}
+
+func (s *Server) createMuteFilter(w http.ResponseWriter, r *http.Request) {
+	muteFilter := feedly.MuteFilter{}
+
+	if !decodeBody(w, r, &muteFilter) {
+		return
+	}
+
+	if len(muteFilter.Keywords) == 0 {
+		writeError(w, http.StatusBadRequest, "missing keywords")
+
+		return
+	}
+
+	if len(s.muteFilters) >= *s.profile.AccountLimits.MaxMuteFilters {
+		writeError(w, http.StatusBadRequest, "mute filter limit reached")
+
+		return
+	}
+
+	muteFilter.Created = now()
+	muteFilter.ID = feedly.NewString(newID())
+
+	s.muteFilters[*muteFilter.ID] = &muteFilter
+
+	writeJSON(w, muteFilter)
+}
+
+func (s *Server) updateMuteFilter(w http.ResponseWriter, r *http.Request, muteFilterID string) {
+	existing, ok := s.muteFilters[muteFilterID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "mute filter not found")
+
+		return
+	}
+
+	muteFilter := feedly.MuteFilter{}
+
+	if !decodeBody(w, r, &muteFilter) {
+		return
+	}
+
+	if len(muteFilter.Keywords) == 0 {
+		writeError(w, http.StatusBadRequest, "missing keywords")
+
+		return
+	}
+
+	muteFilter.Created = existing.Created
+	muteFilter.ID = existing.ID
+
+	s.muteFilters[muteFilterID] = &muteFilter
+
+	writeJSON(w, muteFilter)
+}
+
+func (s *Server) deleteMuteFilter(w http.ResponseWriter, muteFilterID string) {
+	if _, ok := s.muteFilters[muteFilterID]; !ok {
+		writeError(w, http.StatusNotFound, "mute filter not found")
+
+		return
+	}
+
+	delete(s.muteFilters, muteFilterID)
+
+	w.WriteHeader(http.StatusOK)
+}
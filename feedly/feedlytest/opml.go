@@ -0,0 +1,125 @@
+package feedlytest
+
+import (
+	"encoding/xml"
+	"net/http"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// handleOPML emulates the OPML endpoints.
+// https://developer.feedly.com/v3/opml/
+func (s *Server) handleOPML(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.exportOPML(w)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.importOPML(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported opml request")
+	}
+}
+
+func (s *Server) exportOPML(w http.ResponseWriter) {
+	opml := feedly.OPML{
+		Version: feedly.NewString("1.0"),
+		Head: &feedly.Head{
+			Title: feedly.NewString("Feedly Test subscriptions"),
+		},
+		Body: &feedly.Body{},
+	}
+
+	for _, collection := range s.sortedCollections() {
+		outline := feedly.Outline{
+			Text:  collection.Label,
+			Title: collection.Label,
+		}
+
+		for _, feed := range collection.Feeds {
+			outline.Outlines = append(outline.Outlines, feedly.Outline{
+				HTMLURL: feed.Website,
+				Text:    feed.Title,
+				Title:   feed.Title,
+				Type:    feedly.NewString("rss"),
+				XMLURL:  feedly.NewString(feedly.StreamID(*feed.ID).FeedURL()),
+			})
+		}
+
+		opml.Body.Outlines = append(opml.Body.Outlines, outline)
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+
+	_, _ = w.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(w).Encode(opml)
+}
+
+func (s *Server) importOPML(w http.ResponseWriter, r *http.Request) {
+	opml := feedly.OPML{}
+
+	if err := xml.NewDecoder(r.Body).Decode(&opml); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid OPML: "+err.Error())
+
+		return
+	}
+
+	if opml.Body == nil {
+		w.WriteHeader(http.StatusOK)
+
+		return
+	}
+
+	for _, outline := range opml.Body.Outlines {
+		if outline.XMLURL != nil {
+			continue
+		}
+
+		label := outline.Text
+		if label == nil {
+			label = outline.Title
+		}
+
+		if label == nil {
+			continue
+		}
+
+		collection := s.collectionByLabel(*label)
+
+		for _, child := range outline.Outlines {
+			if child.XMLURL == nil {
+				continue
+			}
+
+			s.subscribe(collection, feedly.Feed{
+				ID:      feedly.NewString(feedly.FeedStream(*child.XMLURL).String()),
+				Title:   child.Title,
+				Website: child.HTMLURL,
+			})
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// collectionByLabel returns the collection labeled label, creating it if needed.
+func (s *Server) collectionByLabel(label string) *feedly.Collection {
+	for _, collection := range s.collections {
+		if *collection.Label == label {
+			return collection
+		}
+	}
+
+	id := s.collectionID(newID())
+	collection := &feedly.Collection{
+		Created:      now(),
+		Customizable: feedly.NewBool(true),
+		Enterprise:   feedly.NewBool(false),
+		Feeds:        []feedly.Feed{},
+		ID:           feedly.NewString(id),
+		Label:        feedly.NewString(label),
+	}
+
+	s.collections[id] = collection
+
+	return collection
+}
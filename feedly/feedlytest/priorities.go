@@ -0,0 +1,219 @@
+package feedlytest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// handlePriorities emulates the Leo priorities endpoints.
+func (s *Server) handlePriorities(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listPriorities(w, r)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.createPriority(w, r)
+	case len(segments) == 1 && r.Method == http.MethodPut:
+		s.updatePriority(w, r, segments[0])
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		s.deletePriority(w, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported priorities request")
+	}
+}
+
+func (s *Server) listPriorities(w http.ResponseWriter, r *http.Request) {
+	streamID := r.URL.Query().Get("streamId")
+
+	if _, ok := s.collections[streamID]; !ok {
+		writeError(w, http.StatusNotFound, "collection not found")
+
+		return
+	}
+
+	priorities := make([]feedly.Priority, 0)
+
+	for _, priority := range s.sortedPriorities() {
+		if *priority.StreamID == streamID {
+			priorities = append(priorities, *priority)
+		}
+	}
+
+	writeJSON(w, priorities)
+}
+
+func (s *Server) createPriority(w http.ResponseWriter, r *http.Request) {
+	priority := feedly.Priority{}
+
+	if !decodeBody(w, r, &priority) {
+		return
+	}
+
+	if priority.StreamID == nil {
+		writeError(w, http.StatusBadRequest, "missing stream id")
+
+		return
+	}
+
+	if _, ok := s.collections[*priority.StreamID]; !ok {
+		writeError(w, http.StatusNotFound, "collection not found")
+
+		return
+	}
+
+	if !validPriority(w, &priority) {
+		return
+	}
+
+	if priority.Active == nil {
+		priority.Active = feedly.NewBool(true)
+	}
+
+	priority.Created = now()
+	priority.ID = feedly.NewString("user/" + s.UserID + "/priority/" + newID())
+	priority.Updated = priority.Created
+
+	s.priorities[*priority.ID] = &priority
+
+	writeJSON(w, priority)
+}
+
+func (s *Server) updatePriority(w http.ResponseWriter, r *http.Request, priorityID string) {
+	existing, ok := s.priorities[priorityID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "priority not found")
+
+		return
+	}
+
+	priority := feedly.Priority{}
+
+	if !decodeBody(w, r, &priority) {
+		return
+	}
+
+	if !validPriority(w, &priority) {
+		return
+	}
+
+	if priority.Active == nil {
+		priority.Active = existing.Active
+	}
+
+	priority.Created = existing.Created
+	priority.ID = existing.ID
+	priority.StreamID = existing.StreamID
+	priority.Updated = now()
+
+	s.priorities[priorityID] = &priority
+
+	writeJSON(w, priority)
+}
+
+func (s *Server) deletePriority(w http.ResponseWriter, priorityID string) {
+	if _, ok := s.priorities[priorityID]; !ok {
+		writeError(w, http.StatusNotFound, "priority not found")
+
+		return
+	}
+
+	delete(s.priorities, priorityID)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// validPriority reports whether priority has a label and at least one layer, writing an error response otherwise.
+func validPriority(w http.ResponseWriter, priority *feedly.Priority) bool {
+	switch {
+	case priority.Label == nil || *priority.Label == "":
+		writeError(w, http.StatusBadRequest, "missing priority label")
+	case len(priority.Layers) == 0:
+		writeError(w, http.StatusBadRequest, "missing priority layers")
+	default:
+		return true
+	}
+
+	return false
+}
+
+// sortedPriorities returns the priorities ordered by ID.
+func (s *Server) sortedPriorities() []*feedly.Priority {
+	priorities := make([]*feedly.Priority, 0, len(s.priorities))
+
+	for _, priority := range s.priorities {
+		priorities = append(priorities, priority)
+	}
+
+	sort.Slice(priorities, func(i int, j int) bool {
+		return *priorities[i].ID < *priorities[j].ID
+	})
+
+	return priorities
+}
+
+// entryPriorities returns the active priorities prioritising e. An entry is prioritised when it belongs to the
+// collection of a priority and mentions, in its title, summary, or content, a part of each of the priority's layers.
+func (s *Server) entryPriorities(e *entry) []feedly.EntryPriority {
+	var entryPriorities []feedly.EntryPriority
+
+	for _, priority := range s.sortedPriorities() {
+		if (priority.Active != nil && !*priority.Active) || !s.inCollection(e, *priority.StreamID) {
+			continue
+		}
+
+		layers := make([]feedly.PriorityLayer, 0, len(priority.Layers))
+
+		for _, layer := range priority.Layers {
+			matched := layer
+			matched.Parts = nil
+
+			for _, part := range layer.Parts {
+				if part.Label != nil && mentions(e, strings.ToLower(*part.Label)) {
+					matched.Parts = append(matched.Parts, part)
+				}
+			}
+
+			if len(matched.Parts) == 0 {
+				break
+			}
+
+			layers = append(layers, matched)
+		}
+
+		if len(layers) != len(priority.Layers) {
+			continue
+		}
+
+		entryPriorities = append(entryPriorities, feedly.EntryPriority{
+			ActionTimestamp: priority.Updated,
+			ID:              priority.ID,
+			Label:           priority.Label,
+			Layers:          layers,
+		})
+	}
+
+	return entryPriorities
+}
+
+// inCollection reports whether e belongs to a feed of the collection collectionID.
+func (s *Server) inCollection(e *entry, collectionID string) bool {
+	collection, ok := s.collections[collectionID]
+	if !ok || e.Origin == nil || e.Origin.StreamID == nil {
+		return false
+	}
+
+	for _, feed := range collection.Feeds {
+		if *feed.ID == *e.Origin.StreamID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mentions reports whether the title, summary, or content of e contains query, which must be lower case.
+func mentions(e *entry, query string) bool {
+	return containsFold(e.Title, query) || (e.Summary != nil && containsFold(e.Summary.Content, query)) || (e.Content != nil && containsFold(e.Content.Content, query))
+}
@@ -0,0 +1,66 @@
+package feedlytest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+// deletePreference is the value deleting a preference.
+const deletePreference = "==DELETE=="
+
+// handleProfile emulates the profile endpoints.
+// https://developer.feedly.com/v3/profile/
+func (s *Server) handleProfile(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, s.profile)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		// Only the fields present in the body are updated.
+		if err := json.Unmarshal(b, s.profile); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+
+			return
+		}
+
+		s.profile.ID = &s.UserID
+
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported profile request")
+	}
+}
+
+// handlePreferences emulates the preferences endpoints.
+// https://developer.feedly.com/v3/preferences/
+func (s *Server) handlePreferences(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, s.preferences)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		preferences := make(map[string]string)
+
+		if !decodeBody(w, r, &preferences) {
+			return
+		}
+
+		for key, value := range preferences {
+			if value == deletePreference {
+				delete(s.preferences, key)
+			} else {
+				s.preferences[key] = value
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported preferences request")
+	}
+}
@@ -0,0 +1,70 @@
+package feedlytest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// handleRecommendations emulates the topic recommendations endpoint, recommending the registered feeds covering the
+// topics matching the query.
+func (s *Server) handleRecommendations(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) != 1 || segments[0] != "topics" || r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "unsupported recommendations request")
+
+		return
+	}
+
+	query := strings.ToLower(r.URL.Query().Get("query"))
+	locale := r.URL.Query().Get("locale")
+
+	count := defaultCount
+	if c, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && c > 0 {
+		count = c
+	}
+
+	feedsByTopic := make(map[string][]feedly.Feed)
+
+	for _, feed := range s.feeds {
+		for _, topic := range feed.Topics {
+			if containsFold(&topic, query) {
+				feedsByTopic[topic] = append(feedsByTopic[topic], *feed)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(feedsByTopic))
+
+	for name := range feedsByTopic {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	topics := make([]feedly.Topic, 0, len(names))
+
+	for _, name := range names {
+		feeds := feedsByTopic[name]
+
+		sort.Slice(feeds, func(i int, j int) bool {
+			return *feeds[i].ID < *feeds[j].ID
+		})
+
+		if len(feeds) > count {
+			feeds = feeds[:count]
+		}
+
+		topics = append(topics, feedly.Topic{
+			Language:         feedly.NewString(locale),
+			RecommendedFeeds: feeds,
+			Size:             feedly.NewInt(len(feedsByTopic[name])),
+			Topic:            feedly.NewString(name),
+			TopicID:          feedly.NewString(feedly.TopicStream(name).String()),
+		})
+	}
+
+	writeJSON(w, topics)
+}
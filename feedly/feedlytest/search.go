@@ -0,0 +1,87 @@
+package feedlytest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// handleSearch emulates the search endpoints.
+// https://developer.feedly.com/v3/search/
+func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 1 && segments[0] == "feeds" && r.Method == http.MethodGet:
+		s.searchFeeds(w, r)
+	case len(segments) == 1 && segments[0] == "contents" && r.Method == http.MethodGet:
+		s.searchContents(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported search request")
+	}
+}
+
+func (s *Server) searchFeeds(w http.ResponseWriter, r *http.Request) {
+	query := strings.ToLower(r.URL.Query().Get("query"))
+	results := make([]feedly.Feed, 0)
+
+	for _, feed := range s.feeds {
+		if containsFold(feed.ID, query) || containsFold(feed.Title, query) || containsFold(feed.Website, query) || containsFold(feed.Description, query) {
+			results = append(results, *feed)
+		}
+	}
+
+	sort.Slice(results, func(i int, j int) bool {
+		return *results[i].ID < *results[j].ID
+	})
+
+	queryType := "term"
+	if strings.Contains(query, "://") {
+		queryType = "url"
+	}
+
+	writeJSON(w, feedly.SearchFeedsResponse{
+		QueryType: feedly.NewString(queryType),
+		Results:   results,
+	})
+}
+
+func (s *Server) searchContents(w http.ResponseWriter, r *http.Request) {
+	streamID := r.URL.Query().Get("streamId")
+	query := strings.ToLower(r.URL.Query().Get("query"))
+
+	entries, ok := s.streamEntries(streamID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "stream not found")
+
+		return
+	}
+
+	matching := make([]*entry, 0)
+
+	for _, e := range entries {
+		if containsFold(e.Title, query) || containsFold(e.Author, query) || (e.Content != nil && containsFold(e.Content.Content, query)) || (e.Summary != nil && containsFold(e.Summary.Content, query)) {
+			matching = append(matching, e)
+		}
+	}
+
+	page, continuation := paginate(filterEntries(matching, r.URL.Query()), r.URL.Query())
+	items := make([]feedly.Entry, 0, len(page))
+
+	for _, e := range page {
+		items = append(items, s.render(e))
+	}
+
+	writeJSON(w, feedly.Stream{
+		Continuation: continuation,
+		ID:           feedly.NewString(streamID),
+		Items:        items,
+		Title:        s.streamTitle(streamID),
+		Updated:      now(),
+	})
+}
+
+// containsFold reports whether the lower cased s contains query, which must be lower case.
+func containsFold(s *string, query string) bool {
+	return s != nil && strings.Contains(strings.ToLower(*s), query)
+}
@@ -0,0 +1,511 @@
+// Package feedlytest provides an in-process fake of the Feedly API for testing code built on the feedly package
+// without network access or an OAuth2 token.
+//
+// The fake keeps an in-memory model of a single user's account, so requests observe each other's effects:
+//
+//	server := feedlytest.NewServer()
+//	defer server.Close()
+//
+//	server.AddEntries("feed/https://example.com/rss", feedly.Entry{Title: feedly.NewString("Hello")})
+//
+//	client := server.Client()
+//	createResponse, _, _ := client.Collections.Create("news", &feedly.CollectionCreateOptionalParams{
+//		Feeds: []feedly.Feed{{ID: feedly.NewString("feed/https://example.com/rss")}},
+//	})
+//	contentResponse, _, _ := client.Streams.Content(feedly.StreamID(*createResponse.Collections[0].ID), nil)
+package feedlytest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+	pkgtime "github.com/sfanous/go-feedly/pkg/time"
+)
+
+const (
+	// DefaultUserID is the ID of the user owning the account emulated by a Server.
+	DefaultUserID = "00000000-0000-0000-0000-000000000000"
+	// DefaultMaxMuteFilters is the maximum number of mute filters of the account emulated by a Server.
+	DefaultMaxMuteFilters = 3
+)
+
+// Server is a fake Feedly API server backed by an in-memory account.
+type Server struct {
+	*httptest.Server
+	// UserID is the ID of the user owning the emulated account.
+	UserID string
+	// EnterpriseName is the name of the enterprise the user is a member of, or empty for an account without a team.
+	EnterpriseName string
+
+	mu sync.Mutex
+	// accessTokens maps the access tokens issued by the auth endpoints to their refresh token.
+	accessTokens map[string]string
+	alerts       map[string]*feedly.Alert
+	annotations  map[string]*feedly.Annotation
+	// authCodes maps the authorization codes issued by the auth endpoints to their redirect URI.
+	authCodes   map[string]string
+	boards      map[string]*feedly.Board
+	collections map[string]*feedly.Collection
+	// cover is the cover of the user's library, or nil if the user has no library.
+	cover      *feedly.Cover
+	emailFeeds map[string]*feedly.EmailFeed
+	entities   map[string]*feedly.Entity
+	entries    map[string]*entry
+	feeds      map[string]*feedly.Feed
+	// leoIndustries are the collections listed by the Leo industries endpoint.
+	leoIndustries []feedly.Collection
+	members       map[string]*feedly.TeamMember
+	muteFilters   map[string]*feedly.MuteFilter
+	preferences   map[string]string
+	priorities    map[string]*feedly.Priority
+	profile       *feedly.Profile
+	readLog       []readEvent
+	// refreshTokens are the refresh tokens issued by the auth endpoints and not revoked.
+	refreshTokens map[string]struct{}
+	// sharedResources maps the IDs of the collections shared through the library to their sharing scope.
+	sharedResources map[string]sharedResource
+	tagLog          []tagEvent
+	// teamBoards and teamCollections hold the boards and collections of the enterprise.
+	teamBoards      map[string]*feedly.Board
+	teamCollections map[string]*feedly.Collection
+	// uncategorized holds the subscriptions that are not part of any collection.
+	uncategorized map[string]*feedly.Feed
+	undo          map[string][]string
+}
+
+// entry is an entry along with the per-user state the fake keeps about it.
+type entry struct {
+	feedly.Entry
+	unread bool
+	tags   map[string]time.Time
+}
+
+// readEvent records an entry being marked as read or unread.
+type readEvent struct {
+	entryID string
+	unread  bool
+	at      time.Time
+}
+
+// tagEvent records an entry being tagged.
+type tagEvent struct {
+	entryID string
+	tagID   string
+	at      time.Time
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		UserID:          DefaultUserID,
+		accessTokens:    make(map[string]string),
+		alerts:          make(map[string]*feedly.Alert),
+		annotations:     make(map[string]*feedly.Annotation),
+		authCodes:       make(map[string]string),
+		boards:          make(map[string]*feedly.Board),
+		collections:     make(map[string]*feedly.Collection),
+		emailFeeds:      make(map[string]*feedly.EmailFeed),
+		entities:        make(map[string]*feedly.Entity),
+		entries:         make(map[string]*entry),
+		feeds:           make(map[string]*feedly.Feed),
+		members:         make(map[string]*feedly.TeamMember),
+		muteFilters:     make(map[string]*feedly.MuteFilter),
+		preferences:     make(map[string]string),
+		priorities:      make(map[string]*feedly.Priority),
+		refreshTokens:   make(map[string]struct{}),
+		sharedResources: make(map[string]sharedResource),
+		teamBoards:      make(map[string]*feedly.Board),
+		teamCollections: make(map[string]*feedly.Collection),
+		uncategorized:   make(map[string]*feedly.Feed),
+		undo:            make(map[string][]string),
+	}
+
+	s.profile = &feedly.Profile{
+		AccountLimits: &struct {
+			MaxAlerts      *int                   `json:"maxAlerts,omitempty"`
+			MaxEmailFeeds  *int                   `json:"maxEmailFeeds,omitempty"`
+			MaxFeeds       *int                   `json:"maxFeeds,omitempty"`
+			MaxLeoFeeds    *int                   `json:"maxLeoFeeds,omitempty"`
+			MaxMuteFilters *int                   `json:"maxMuteFilters,omitempty"`
+			MaxPriorities  *int                   `json:"maxPriorities,omitempty"`
+			UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+		}{
+			MaxMuteFilters: feedly.NewInt(DefaultMaxMuteFilters),
+		},
+		Client:   feedly.NewString("feedlytest"),
+		Created:  now(),
+		Email:    feedly.NewString("feedlytest@example.com"),
+		FullName: feedly.NewString("Feedly Test"),
+		ID:       feedly.NewString(s.UserID),
+		Locale:   feedly.NewString("en"),
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Client returns a feedly.Client sending its requests to the Server.
+func (s *Server) Client(optionalParameters ...func(*feedly.Client)) *feedly.Client {
+	return feedly.NewClient(s.Server.Client(), append([]func(*feedly.Client){feedly.WithAPIBaseURL(s.URL)}, optionalParameters...)...)
+}
+
+// AddFeed registers feed with the Server, making it available to FeedService, SearchService, and subscriptions. feed
+// must have an ID.
+func (s *Server) AddFeed(feed feedly.Feed) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.registerFeed(feed)
+}
+
+// AddEntries adds entries to the feed feedID, registering the feed if needed, and returns the IDs of the added
+// entries. Entries without an ID, a crawled timestamp, or an origin are assigned one. Added entries are unread.
+func (s *Server) AddEntries(feedID string, entries ...feedly.Entry) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	feed := s.registerFeed(feedly.Feed{ID: feedly.NewString(feedID)})
+	entryIDs := make([]string, 0, len(entries))
+
+	for _, e := range entries {
+		if e.ID == nil {
+			e.ID = feedly.NewString(newID())
+		}
+
+		if e.Crawled == nil {
+			e.Crawled = now()
+		}
+
+		if e.Origin == nil {
+			e.Origin = &struct {
+				HTMLURL        *string                `json:"htmlUrl,omitempty"`
+				StreamID       *string                `json:"streamId,omitempty"`
+				Title          *string                `json:"title,omitempty"`
+				UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+			}{
+				HTMLURL:  feed.Website,
+				StreamID: feedly.NewString(feedID),
+				Title:    feed.Title,
+			}
+		}
+
+		s.entries[*e.ID] = &entry{
+			Entry:  e,
+			unread: true,
+			tags:   make(map[string]time.Time),
+		}
+
+		entryIDs = append(entryIDs, *e.ID)
+	}
+
+	return entryIDs
+}
+
+// registerFeed registers feed, merging it with an already registered feed with the same ID.
+func (s *Server) registerFeed(feed feedly.Feed) *feedly.Feed {
+	if registered, ok := s.feeds[*feed.ID]; ok {
+		if feed.Title != nil {
+			registered.Title = feed.Title
+		}
+
+		return registered
+	}
+
+	if feed.FeedID == nil {
+		feed.FeedID = feed.ID
+	}
+
+	if feed.Title == nil {
+		feed.Title = feedly.NewString(strings.TrimPrefix(*feed.ID, "feed/"))
+	}
+
+	if feed.Website == nil {
+		feed.Website = feedly.NewString(strings.TrimPrefix(*feed.ID, "feed/"))
+	}
+
+	s.feeds[*feed.ID] = &feed
+
+	return &feed
+}
+
+// serveHTTP routes a request to the handler of the emulated endpoint.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments, ok := pathSegments(r)
+	if !ok {
+		writeError(w, http.StatusNotFound, "unknown endpoint")
+
+		return
+	}
+
+	var handler func(http.ResponseWriter, *http.Request, []string)
+
+	switch segments[0] {
+	case "alerts":
+		handler = s.handleAlerts
+	case "alias":
+		handler = s.handleAlias
+	case "annotations":
+		handler = s.handleAnnotations
+	case "auth":
+		handler = s.handleAuth
+	case "boards":
+		handler = s.handleBoards
+	case "collections":
+		handler = s.handleCollections
+	case "emailfeeds":
+		handler = s.handleEmailFeeds
+	case "enterprise":
+		handler = s.handleEnterprise
+	case "entities":
+		handler = s.handleEntities
+	case "entries":
+		handler = s.handleEntries
+	case "feeds":
+		handler = s.handleFeeds
+	case "library":
+		handler = s.handleLibrary
+	case "markers":
+		handler = s.handleMarkers
+	case "mixes":
+		handler = s.handleMixes
+	case "mutefilters":
+		handler = s.handleMuteFilters
+	case "opml":
+		handler = s.handleOPML
+	case "preferences":
+		handler = s.handlePreferences
+	case "priorities":
+		handler = s.handlePriorities
+	case "profile":
+		handler = s.handleProfile
+	case "recommendations":
+		handler = s.handleRecommendations
+	case "search":
+		handler = s.handleSearch
+	case "shorten":
+		handler = s.handleShorten
+	case "streams":
+		handler = s.handleStreams
+	case "subscriptions":
+		handler = s.handleSubscriptions
+	case "tags":
+		handler = s.handleTags
+	default:
+		writeError(w, http.StatusNotFound, "unknown endpoint")
+
+		return
+	}
+
+	handler(w, r, segments[1:])
+}
+
+// pathSegments returns the unescaped segments of the request path following the API version.
+func pathSegments(r *http.Request) ([]string, bool) {
+	escapedPath := strings.TrimPrefix(r.URL.EscapedPath(), "/")
+
+	parts := strings.Split(escapedPath, "/")
+	if len(parts) < 2 || parts[0] != feedly.APIBaseVersion {
+		return nil, false
+	}
+
+	segments := make([]string, 0, len(parts)-1)
+
+	for _, part := range parts[1:] {
+		segment, err := url.PathUnescape(part)
+		if err != nil {
+			return nil, false
+		}
+
+		segments = append(segments, segment)
+	}
+
+	return segments, true
+}
+
+// splitIDs returns the comma separated IDs of the path segment segment, as indexed in the segments returned by
+// pathSegments. The IDs are split before being unescaped, so escaped commas are part of an ID.
+func splitIDs(r *http.Request, segment int) []string {
+	parts := strings.Split(strings.TrimPrefix(r.URL.EscapedPath(), "/"), "/")
+	ids := make([]string, 0)
+
+	for _, escaped := range strings.Split(parts[segment+1], ",") {
+		id, err := url.PathUnescape(escaped)
+		if err != nil {
+			id = escaped
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// collectionID returns the ID of the collection label of the user.
+func (s *Server) collectionID(label string) string {
+	return feedly.CategoryStream(s.UserID, label).String()
+}
+
+// tagID returns the ID of the tag label of the user.
+func (s *Server) tagID(label string) string {
+	return feedly.TagStream(s.UserID, label).String()
+}
+
+// subscribedFeedIDs returns the IDs of the feeds subscribed to, in any collection or uncategorized.
+func (s *Server) subscribedFeedIDs() []string {
+	unique := make(map[string]struct{})
+
+	for feedID := range s.uncategorized {
+		unique[feedID] = struct{}{}
+	}
+
+	for _, collection := range s.collections {
+		for _, feed := range collection.Feeds {
+			unique[*feed.ID] = struct{}{}
+		}
+	}
+
+	feedIDs := make([]string, 0, len(unique))
+
+	for feedID := range unique {
+		feedIDs = append(feedIDs, feedID)
+	}
+
+	sort.Strings(feedIDs)
+
+	return feedIDs
+}
+
+// sortedCollections returns the collections ordered by label.
+func (s *Server) sortedCollections() []*feedly.Collection {
+	return sortCollections(s.collections)
+}
+
+// sortedBoards returns the boards ordered by label.
+func (s *Server) sortedBoards() []*feedly.Board {
+	return sortBoards(s.boards)
+}
+
+// sortCollections returns the collections of collectionsByID ordered by label.
+func sortCollections(collectionsByID map[string]*feedly.Collection) []*feedly.Collection {
+	collections := make([]*feedly.Collection, 0, len(collectionsByID))
+
+	for _, collection := range collectionsByID {
+		collections = append(collections, collection)
+	}
+
+	sort.Slice(collections, func(i int, j int) bool {
+		return *collections[i].Label < *collections[j].Label
+	})
+
+	return collections
+}
+
+// sortBoards returns the boards of boardsByID ordered by label.
+func sortBoards(boardsByID map[string]*feedly.Board) []*feedly.Board {
+	boards := make([]*feedly.Board, 0, len(boardsByID))
+
+	for _, board := range boardsByID {
+		boards = append(boards, board)
+	}
+
+	sort.Slice(boards, func(i int, j int) bool {
+		return *boards[i].Label < *boards[j].Label
+	})
+
+	return boards
+}
+
+// render returns a copy of e as returned by the API, reflecting the state of the user.
+func (s *Server) render(e *entry) feedly.Entry {
+	rendered := e.Entry
+	rendered.Annotations = s.entryAnnotations(*e.ID)
+	rendered.Priorities = s.entryPriorities(e)
+	rendered.Unread = feedly.NewBool(e.unread)
+	rendered.Tags = nil
+
+	tagIDs := make([]string, 0, len(e.tags))
+
+	for tagID := range e.tags {
+		tagIDs = append(tagIDs, tagID)
+	}
+
+	sort.Strings(tagIDs)
+
+	for _, tagID := range tagIDs {
+		tag := feedly.Board{
+			ID:    feedly.NewString(tagID),
+			Label: feedly.NewString(feedly.StreamID(tagID).Label()),
+		}
+
+		if board, ok := s.boards[tagID]; ok {
+			tag.Label = board.Label
+		}
+
+		if board, ok := s.teamBoards[tagID]; ok {
+			tag.Label = board.Label
+		}
+
+		rendered.Tags = append(rendered.Tags, tag)
+	}
+
+	return rendered
+}
+
+// decodeBody decodes the JSON request body into v, writing an error response and returning false on failure.
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+
+		return false
+	}
+
+	return true
+}
+
+// writeJSON writes v as a JSON response.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a Feedly API error response.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"errorCode":    statusCode,
+		"errorId":      "feedlytest." + newID()[:8],
+		"errorMessage": message,
+	})
+}
+
+// newID returns a new random ID.
+func newID() string {
+	b := make([]byte, 16)
+
+	_, _ = rand.Read(b)
+
+	s := hex.EncodeToString(b)
+
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:32]
+}
+
+// now returns the current time truncated to the millisecond precision of the API.
+func now() *pkgtime.Time {
+	return &pkgtime.Time{Time: time.Now().Truncate(time.Millisecond)}
+}
@@ -0,0 +1,313 @@
+package feedlytest_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/sfanous/go-feedly/feedly/feedlytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testFeedID = "feed/https://example.com/rss"
+
+func newTestServer(t *testing.T, numberOfEntries int) (*feedlytest.Server, []string) {
+	server := feedlytest.NewServer()
+	t.Cleanup(server.Close)
+
+	entries := make([]feedly.Entry, 0, numberOfEntries)
+
+	for i := 0; i < numberOfEntries; i++ {
+		entries = append(entries, feedly.Entry{
+			Title: feedly.NewString("Entry"),
+		})
+	}
+
+	return server, server.AddEntries(testFeedID, entries...)
+}
+
+func TestCollectionsAndStreams(t *testing.T) {
+	server, entryIDs := newTestServer(t, 25)
+	client := server.Client()
+
+	createResponse, _, err := client.Collections.Create("news", &feedly.CollectionCreateOptionalParams{
+		Feeds: []feedly.Feed{{ID: feedly.NewString(testFeedID)}},
+	})
+	require.NoError(t, err)
+	require.Len(t, createResponse.Collections, 1)
+
+	collectionID := *createResponse.Collections[0].ID
+
+	listResponse, _, err := client.Collections.List(nil)
+	require.NoError(t, err)
+	assert.Len(t, listResponse.Collections, 1)
+
+	contentResponse, _, err := client.Streams.Content(feedly.StreamID(collectionID), &feedly.StreamContentOptionalParams{
+		Count: feedly.NewInt(20),
+	})
+	require.NoError(t, err)
+	assert.Len(t, contentResponse.Stream.Items, 20)
+	assert.NotNil(t, contentResponse.Stream.Continuation)
+
+	iterator := client.Streams.Iterate(feedly.StreamID(collectionID), nil, nil)
+	count := 0
+
+	for iterator.Next() {
+		count++
+	}
+
+	assert.NoError(t, iterator.Err())
+	assert.Equal(t, len(entryIDs), count)
+
+	_, err = client.Collections.DeleteFeed(feedly.StreamID(collectionID), testFeedID, nil)
+	require.NoError(t, err)
+
+	contentResponse, _, err = client.Streams.Content(feedly.StreamID(collectionID), nil)
+	require.NoError(t, err)
+	assert.Empty(t, contentResponse.Stream.Items)
+
+	_, err = client.Collections.Delete(feedly.StreamID(collectionID))
+	require.NoError(t, err)
+
+	_, _, err = client.Collections.Details(feedly.StreamID(collectionID))
+	assert.True(t, errors.Is(err, feedly.ErrNotFound))
+}
+
+func TestBoardsAndMarkers(t *testing.T) {
+	server, entryIDs := newTestServer(t, 3)
+	client := server.Client()
+
+	createResponse, _, err := client.Boards.Create("research", nil)
+	require.NoError(t, err)
+
+	boardID := *createResponse.Boards[0].ID
+
+	_, err = client.Boards.AddMultipleEntries([]string{boardID}, entryIDs[:2])
+	require.NoError(t, err)
+
+	entryIDsResponse, _, err := client.Streams.EntryIDs(feedly.StreamID(boardID), nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, entryIDs[:2], entryIDsResponse.IDs)
+
+	latestTaggedResponse, _, err := client.Markers.LatestTagged(nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, entryIDs[:2], latestTaggedResponse.TaggedEntries[boardID])
+
+	_, err = client.Markers.Mark(feedly.MarkAsRead, feedly.Entries, &feedly.MarkerMarkOptionalParams{
+		EntryIDs: entryIDs[:1],
+	})
+	require.NoError(t, err)
+
+	contentResponse, _, err := client.Entries.Content(entryIDs[0])
+	require.NoError(t, err)
+	require.Len(t, contentResponse.Entries, 1)
+	assert.False(t, *contentResponse.Entries[0].Unread)
+	assert.Len(t, contentResponse.Entries[0].Tags, 1)
+
+	latestReadResponse, _, err := client.Markers.LatestRead(nil)
+	require.NoError(t, err)
+	assert.Equal(t, entryIDs[:1], latestReadResponse.Entries)
+
+	_, err = client.Markers.Mark(feedly.MarkAsSaved, feedly.Entries, &feedly.MarkerMarkOptionalParams{
+		EntryIDs: entryIDs[2:],
+	})
+	require.NoError(t, err)
+
+	savedResponse, _, err := client.Streams.EntryIDs(feedly.StreamID(feedly.GlobalSaved(server.UserID).String()), nil)
+	require.NoError(t, err)
+	assert.Equal(t, entryIDs[2:], savedResponse.IDs)
+
+	_, err = client.Boards.Delete([]string{boardID})
+	require.NoError(t, err)
+
+	listResponse, _, err := client.Boards.List(nil)
+	require.NoError(t, err)
+	assert.Empty(t, listResponse.Boards)
+}
+
+func TestUnreadCounts(t *testing.T) {
+	server, _ := newTestServer(t, 4)
+	client := server.Client()
+
+	createResponse, _, err := client.Collections.Create("news", &feedly.CollectionCreateOptionalParams{
+		Feeds: []feedly.Feed{{ID: feedly.NewString(testFeedID)}},
+	})
+	require.NoError(t, err)
+
+	collectionID := *createResponse.Collections[0].ID
+
+	_, err = client.Markers.Mark(feedly.MarkAsRead, feedly.Collections, &feedly.MarkerMarkOptionalParams{
+		CollectionIDs: []string{collectionID},
+	})
+	require.NoError(t, err)
+
+	unreadCountsResponse, _, err := client.Markers.UnreadCounts(nil)
+	require.NoError(t, err)
+
+	for _, unreadCount := range unreadCountsResponse.UnreadCounts {
+		assert.Equal(t, 0, *unreadCount.Count, *unreadCount.ID)
+	}
+
+	_, err = client.Markers.Mark(feedly.UndoMarkAsRead, feedly.Collections, &feedly.MarkerMarkOptionalParams{
+		CollectionIDs: []string{collectionID},
+	})
+	require.NoError(t, err)
+
+	unreadCountsResponse, _, err = client.Markers.UnreadCounts(nil)
+	require.NoError(t, err)
+
+	for _, unreadCount := range unreadCountsResponse.UnreadCounts {
+		assert.Equal(t, 4, *unreadCount.Count, *unreadCount.ID)
+	}
+}
+
+func TestOPML(t *testing.T) {
+	server, _ := newTestServer(t, 0)
+	client := server.Client()
+
+	_, _, err := client.Collections.Create("news", &feedly.CollectionCreateOptionalParams{
+		Feeds: []feedly.Feed{{ID: feedly.NewString(testFeedID)}},
+	})
+	require.NoError(t, err)
+
+	exportResponse, _, err := client.OPML.Export()
+	require.NoError(t, err)
+	require.Len(t, exportResponse.OPML.Body.Outlines, 1)
+	assert.Equal(t, "https://example.com/rss", *exportResponse.OPML.Body.Outlines[0].Outlines[0].XMLURL)
+
+	other := feedlytest.NewServer()
+	defer other.Close()
+
+	_, err = other.Client().OPML.Import(bytes.NewBufferString(`<opml version="1.0"><body><outline text="news"><outline type="rss" xmlUrl="https://example.com/rss"/></outline></body></opml>`))
+	require.NoError(t, err)
+
+	listResponse, _, err := other.Client().Collections.List(nil)
+	require.NoError(t, err)
+	require.Len(t, listResponse.Collections, 1)
+	assert.Equal(t, testFeedID, *listResponse.Collections[0].Feeds[0].ID)
+}
+
+func TestPreferencesAndProfile(t *testing.T) {
+	server, _ := newTestServer(t, 0)
+	client := server.Client()
+
+	_, err := client.Preferences.Update(map[string]string{"theme": "dark"})
+	require.NoError(t, err)
+
+	listResponse, _, err := client.Preferences.List()
+	require.NoError(t, err)
+	assert.Equal(t, "dark", listResponse.Preferences["theme"])
+
+	_, err = client.Preferences.Update(map[string]string{"theme": "==DELETE=="})
+	require.NoError(t, err)
+
+	listResponse, _, err = client.Preferences.List()
+	require.NoError(t, err)
+	assert.NotContains(t, listResponse.Preferences, "theme")
+
+	_, err = client.Profile.Update(&feedly.Profile{GivenName: feedly.NewString("Ada")})
+	require.NoError(t, err)
+
+	profileResponse, _, err := client.Profile.List()
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", *profileResponse.Profile.GivenName)
+	assert.Equal(t, server.UserID, *profileResponse.Profile.ID)
+}
+
+func TestSearch(t *testing.T) {
+	server, _ := newTestServer(t, 2)
+	client := server.Client()
+
+	feedsResponse, _, err := client.Search.Feeds("example", nil)
+	require.NoError(t, err)
+	require.Len(t, feedsResponse.Results, 1)
+
+	streamResponse, _, err := client.Search.Stream(testFeedID, "entry", nil)
+	require.NoError(t, err)
+	assert.Len(t, streamResponse.Items, 2)
+}
+
+func TestLibrary(t *testing.T) {
+	server, _ := newTestServer(t, 0)
+	client := server.Client()
+
+	server.AddLeoIndustry(feedly.Collection{
+		Feeds:  []feedly.Feed{{ID: feedly.NewString(testFeedID), Topics: []string{"tech"}}},
+		Label:  feedly.NewString("Tech"),
+		Topics: []string{"tech"},
+	})
+
+	leoIndustriesResponse, _, err := client.Library.LeoIndustries()
+	require.NoError(t, err)
+	require.Len(t, leoIndustriesResponse.Collections, 1)
+	assert.Equal(t, "Tech", *leoIndustriesResponse.Collections[0].Label)
+
+	_, _, err = client.Library.Cover()
+	assert.True(t, errors.Is(err, feedly.ErrNotFound))
+
+	_, _, err = client.Library.UpdateCover(&feedly.Cover{Alias: feedly.NewString("me")})
+	require.NoError(t, err)
+
+	aliasAvailableResponse, _, err := client.Library.AliasAvailable("me")
+	require.NoError(t, err)
+	assert.False(t, *aliasAvailableResponse.Available)
+
+	createResponse, _, err := client.Collections.Create("news", nil)
+	require.NoError(t, err)
+
+	collectionID := feedly.StreamID(*createResponse.Collections[0].ID)
+
+	_, err = client.Library.ShareResource(collectionID)
+	require.NoError(t, err)
+
+	listSharedResourcesResponse, _, err := client.Library.ListSharedResources()
+	require.NoError(t, err)
+	assert.Contains(t, listSharedResourcesResponse.SharedResources, collectionID.String())
+
+	detailsResponse, _, err := client.Library.Details("me")
+	require.NoError(t, err)
+	require.Len(t, detailsResponse.Library.Collections, 1)
+
+	_, err = client.Library.UnshareResource(collectionID)
+	require.NoError(t, err)
+
+	_, err = client.Library.Delete()
+	require.NoError(t, err)
+
+	_, _, err = client.Library.Details("me")
+	assert.True(t, errors.Is(err, feedly.ErrNotFound))
+}
+
+func TestMixesAndRecommendations(t *testing.T) {
+	server := feedlytest.NewServer()
+	t.Cleanup(server.Close)
+
+	client := server.Client()
+
+	server.AddFeed(feedly.Feed{ID: feedly.NewString(testFeedID), Topics: []string{"Tech"}})
+
+	for engagement := 1; engagement <= 3; engagement++ {
+		server.AddEntries(testFeedID, feedly.Entry{
+			Engagement: feedly.NewInt(engagement),
+			Title:      feedly.NewString(fmt.Sprintf("Entry %d", engagement)),
+		})
+	}
+
+	mostEngagingResponse, _, err := client.Mixes.MostEngaging(feedly.TopicStream("tech"), &feedly.MixMostEngagingOptionalParams{
+		Count: feedly.NewInt(2),
+	})
+	require.NoError(t, err)
+	require.Len(t, mostEngagingResponse.Stream.Items, 2)
+	assert.Equal(t, "Entry 3", *mostEngagingResponse.Stream.Items[0].Title)
+	assert.Equal(t, "Entry 2", *mostEngagingResponse.Stream.Items[1].Title)
+
+	topicResponse, _, err := client.Recommendations.Topic("tec", "en", nil)
+	require.NoError(t, err)
+	require.Len(t, topicResponse.Topics, 1)
+	assert.Equal(t, "Tech", *topicResponse.Topics[0].Topic)
+	require.Len(t, topicResponse.Topics[0].RecommendedFeeds, 1)
+	assert.Equal(t, testFeedID, *topicResponse.Topics[0].RecommendedFeeds[0].ID)
+}
@@ -0,0 +1,36 @@
+package feedlytest
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+	pkgtime "github.com/sfanous/go-feedly/pkg/time"
+)
+
+// shortLinkLifetime is how long the short links returned by a Server remain valid.
+const shortLinkLifetime = 30 * 24 * time.Hour
+
+// handleShorten emulates the shorten endpoint.
+// https://developer.feedly.com/v3/shorten/
+func (s *Server) handleShorten(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) != 2 || segments[0] != "entries" || r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "unsupported shorten request")
+
+		return
+	}
+
+	if _, ok := s.entries[segments[1]]; !ok {
+		writeError(w, http.StatusNotFound, "entry not found")
+
+		return
+	}
+
+	expiresOn := pkgtime.Time{Time: now().Add(shortLinkLifetime)}
+
+	writeJSON(w, feedly.ShareShortenEntryResponse{
+		ExpiresOn: &expiresOn,
+		ShortURL:  feedly.NewString(s.URL + "/e/" + url.PathEscape(segments[1])),
+	})
+}
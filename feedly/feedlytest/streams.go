@@ -0,0 +1,309 @@
+package feedlytest
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+const (
+	defaultCount = 20
+	maxCount     = 1000
+)
+
+// handleStreams emulates the streams endpoints.
+// https://developer.feedly.com/v3/streams/
+func (s *Server) handleStreams(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) != 2 || r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "unsupported streams request")
+
+		return
+	}
+
+	entries, ok := s.streamEntries(segments[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "stream not found")
+
+		return
+	}
+
+	page, continuation := paginate(filterEntries(entries, r.URL.Query()), r.URL.Query())
+
+	switch segments[1] {
+	case "contents":
+		items := make([]feedly.Entry, 0, len(page))
+
+		for _, e := range page {
+			items = append(items, s.render(e))
+		}
+
+		stream := feedly.Stream{
+			Continuation: continuation,
+			ID:           feedly.NewString(segments[0]),
+			Items:        items,
+			Title:        s.streamTitle(segments[0]),
+			Updated:      now(),
+		}
+
+		writeJSON(w, stream)
+	case "ids":
+		ids := make([]string, 0, len(page))
+
+		for _, e := range page {
+			ids = append(ids, *e.ID)
+		}
+
+		writeJSON(w, feedly.StreamEntryIDsResponse{
+			Continuation: continuation,
+			IDs:          ids,
+		})
+	default:
+		writeError(w, http.StatusNotFound, "unknown streams endpoint")
+	}
+}
+
+// streamEntries returns the entries of the stream streamID, newest first.
+func (s *Server) streamEntries(streamID string) ([]*entry, bool) {
+	id := feedly.StreamID(streamID)
+	entries := make([]*entry, 0)
+
+	switch id.Type() {
+	case feedly.FeedStreamType:
+		for _, e := range s.entries {
+			if e.Origin != nil && e.Origin.StreamID != nil && *e.Origin.StreamID == streamID {
+				entries = append(entries, e)
+			}
+		}
+	case feedly.CategoryStreamType:
+		feedIDs := make(map[string]struct{})
+
+		switch id.Label() {
+		case "global.all":
+			for _, feedID := range s.subscribedFeedIDs() {
+				feedIDs[feedID] = struct{}{}
+			}
+		case "global.must", "global.uncategorized":
+		default:
+			collection, ok := s.collections[streamID]
+			if !ok {
+				return nil, false
+			}
+
+			for _, feed := range collection.Feeds {
+				feedIDs[*feed.ID] = struct{}{}
+			}
+		}
+
+		for _, e := range s.entries {
+			if e.Origin == nil || e.Origin.StreamID == nil {
+				continue
+			}
+
+			if _, ok := feedIDs[*e.Origin.StreamID]; ok {
+				entries = append(entries, e)
+			}
+		}
+	case feedly.EnterpriseCategoryStreamType:
+		collection, ok := s.teamCollections[streamID]
+		if !ok {
+			return nil, false
+		}
+
+		entries = s.feedEntries(collection.Feeds)
+	case feedly.EnterpriseTagStreamType:
+		if _, ok := s.teamBoards[streamID]; !ok {
+			return nil, false
+		}
+
+		for _, e := range s.entries {
+			if _, ok := e.tags[streamID]; ok {
+				entries = append(entries, e)
+			}
+		}
+	case feedly.TagStreamType:
+		if _, ok := s.boards[streamID]; !ok && !id.IsGlobal() {
+			return nil, false
+		}
+
+		for _, e := range s.entries {
+			if id.Label() == "global.read" {
+				if !e.unread {
+					entries = append(entries, e)
+				}
+
+				continue
+			}
+
+			if _, ok := e.tags[streamID]; ok {
+				entries = append(entries, e)
+			}
+		}
+	case feedly.TopicStreamType:
+		for _, e := range s.entries {
+			if e.Origin == nil || e.Origin.StreamID == nil {
+				continue
+			}
+
+			if feed, ok := s.feeds[*e.Origin.StreamID]; ok && hasTopic(feed, id.Label()) {
+				entries = append(entries, e)
+			}
+		}
+	case feedly.AlertStreamType:
+		alert, ok := s.streamAlert(streamID)
+		if !ok {
+			return nil, false
+		}
+
+		entries = s.alertEntries(alert)
+	default:
+		return nil, false
+	}
+
+	sortNewestFirst(entries)
+
+	return entries, true
+}
+
+// hasTopic reports whether feed covers topic, regardless of case.
+func hasTopic(feed *feedly.Feed, topic string) bool {
+	for _, t := range feed.Topics {
+		if strings.EqualFold(t, topic) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// feedEntries returns the entries of feeds.
+func (s *Server) feedEntries(feeds []feedly.Feed) []*entry {
+	feedIDs := make(map[string]struct{}, len(feeds))
+
+	for _, feed := range feeds {
+		feedIDs[*feed.ID] = struct{}{}
+	}
+
+	entries := make([]*entry, 0)
+
+	for _, e := range s.entries {
+		if e.Origin == nil || e.Origin.StreamID == nil {
+			continue
+		}
+
+		if _, ok := feedIDs[*e.Origin.StreamID]; ok {
+			entries = append(entries, e)
+		}
+	}
+
+	return entries
+}
+
+// streamTitle returns the title of the stream streamID.
+func (s *Server) streamTitle(streamID string) *string {
+	if feed, ok := s.feeds[streamID]; ok {
+		return feed.Title
+	}
+
+	if collection, ok := s.collections[streamID]; ok {
+		return collection.Label
+	}
+
+	if board, ok := s.boards[streamID]; ok {
+		return board.Label
+	}
+
+	if alert, ok := s.streamAlert(streamID); ok {
+		return alert.Label
+	}
+
+	if collection, ok := s.teamCollections[streamID]; ok {
+		return collection.Label
+	}
+
+	if board, ok := s.teamBoards[streamID]; ok {
+		return board.Label
+	}
+
+	return feedly.NewString(feedly.StreamID(streamID).Label())
+}
+
+// filterEntries applies the newerThan, unreadOnly, and ranked query parameters to entries.
+func filterEntries(entries []*entry, query url.Values) []*entry {
+	filtered := make([]*entry, 0, len(entries))
+
+	newerThan := newerThanParam(query)
+
+	for _, e := range entries {
+		if query.Get("unreadOnly") == "true" && !e.unread {
+			continue
+		}
+
+		if !newerThan.IsZero() && !e.Crawled.Time.After(newerThan) {
+			continue
+		}
+
+		filtered = append(filtered, e)
+	}
+
+	if query.Get("ranked") == "oldest" {
+		for i, j := 0, len(filtered)-1; i < j; i, j = i+1, j-1 {
+			filtered[i], filtered[j] = filtered[j], filtered[i]
+		}
+	}
+
+	return filtered
+}
+
+// paginate returns the page of entries selected by the count and continuation query parameters, and the
+// continuation of the next page, if any.
+func paginate(entries []*entry, query url.Values) ([]*entry, *string) {
+	start, end, continuation := pageBounds(len(entries), query)
+
+	return entries[start:end], continuation
+}
+
+// pageBounds returns the bounds of the page of n items selected by the count and continuation query parameters, and
+// the continuation of the next page, if any.
+func pageBounds(n int, query url.Values) (int, int, *string) {
+	count := defaultCount
+
+	if c, err := strconv.Atoi(query.Get("count")); err == nil && c > 0 {
+		count = c
+	}
+
+	if count > maxCount {
+		count = maxCount
+	}
+
+	offset := 0
+
+	if o, err := strconv.Atoi(query.Get("continuation")); err == nil && o > 0 {
+		offset = o
+	}
+
+	if offset > n {
+		offset = n
+	}
+
+	end := offset + count
+	if end >= n {
+		return offset, n, nil
+	}
+
+	return offset, end, feedly.NewString(strconv.Itoa(end))
+}
+
+// sortNewestFirst sorts entries by crawled timestamp, newest first.
+func sortNewestFirst(entries []*entry) {
+	sort.Slice(entries, func(i int, j int) bool {
+		if !entries[i].Crawled.Time.Equal(entries[j].Crawled.Time) {
+			return entries[i].Crawled.Time.After(entries[j].Crawled.Time)
+		}
+
+		return strings.Compare(*entries[i].ID, *entries[j].ID) < 0
+	})
+}
@@ -0,0 +1,195 @@
+package feedlytest
+
+import (
+	"net/http"
+
+	"github.com/sfanous/go-feedly/feedly"
+)
+
+// subscriptionBody is the request body of the subscription endpoints.
+type subscriptionBody struct {
+	Categories []feedly.Collection `json:"categories,omitempty"`
+	ID         *string             `json:"id,omitempty"`
+	Title      *string             `json:"title,omitempty"`
+}
+
+// handleSubscriptions emulates the subscriptions endpoints.
+// https://developer.feedly.com/v3/subscriptions/
+func (s *Server) handleSubscriptions(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		s.listSubscriptions(w)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		s.saveSubscriptions(w, r, false)
+	case len(segments) == 1 && segments[0] == ".mput" && r.Method == http.MethodPost:
+		s.saveSubscriptions(w, r, true)
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		s.unsubscribe(w, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported subscriptions request")
+	}
+}
+
+func (s *Server) listSubscriptions(w http.ResponseWriter) {
+	subscriptions := make([]feedly.Feed, 0)
+
+	for _, feedID := range s.subscribedFeedIDs() {
+		subscriptions = append(subscriptions, s.subscription(feedID))
+	}
+
+	writeJSON(w, subscriptions)
+}
+
+func (s *Server) saveSubscriptions(w http.ResponseWriter, r *http.Request, multiple bool) {
+	bodies := make([]subscriptionBody, 0)
+
+	if multiple {
+		if !decodeBody(w, r, &bodies) {
+			return
+		}
+	} else {
+		body := subscriptionBody{}
+
+		if !decodeBody(w, r, &body) {
+			return
+		}
+
+		bodies = append(bodies, body)
+	}
+
+	for _, body := range bodies {
+		if body.ID == nil || feedly.StreamID(*body.ID).Type() != feedly.FeedStreamType {
+			writeError(w, http.StatusBadRequest, "invalid feed id")
+
+			return
+		}
+	}
+
+	subscriptions := make([]feedly.Feed, 0, len(bodies))
+
+	for _, body := range bodies {
+		s.saveSubscription(body)
+
+		subscriptions = append(subscriptions, s.subscription(*body.ID))
+	}
+
+	writeJSON(w, subscriptions)
+}
+
+// saveSubscription subscribes to the feed described by body, replacing its collections.
+func (s *Server) saveSubscription(body subscriptionBody) {
+	feed := feedly.Feed{
+		ID:    body.ID,
+		Title: body.Title,
+	}
+
+	if existing := s.subscription(*body.ID); existing.Added != nil && body.Title == nil {
+		feed.Title = existing.Title
+	}
+
+	targets := make(map[string]*feedly.Collection)
+
+	for _, category := range body.Categories {
+		var collection *feedly.Collection
+
+		if category.ID != nil {
+			collection = s.collections[*category.ID]
+		}
+
+		if collection == nil && category.Label != nil {
+			collection = s.collectionByLabel(*category.Label)
+		}
+
+		if collection != nil {
+			targets[*collection.ID] = collection
+		}
+	}
+
+	for collectionID, collection := range s.collections {
+		if _, ok := targets[collectionID]; !ok {
+			s.removeFromCollection(collection, *body.ID)
+		}
+	}
+
+	for _, collection := range targets {
+		subscribed := s.subscribe(collection, feed)
+
+		if feed.Title != nil {
+			subscribed.Title = feed.Title
+		}
+	}
+
+	if len(targets) == 0 {
+		subscribed := *s.registerFeed(feed)
+
+		if existing, ok := s.uncategorized[*body.ID]; ok {
+			subscribed.Added = existing.Added
+		} else {
+			subscribed.Added = now()
+		}
+
+		if feed.Title != nil {
+			subscribed.Title = feed.Title
+		}
+
+		s.uncategorized[*body.ID] = &subscribed
+	}
+}
+
+func (s *Server) unsubscribe(w http.ResponseWriter, feedID string) {
+	if s.subscription(feedID).Added == nil {
+		writeError(w, http.StatusNotFound, "subscription not found")
+
+		return
+	}
+
+	for _, collection := range s.collections {
+		s.removeFromCollection(collection, feedID)
+	}
+
+	delete(s.uncategorized, feedID)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// subscription returns the subscription to the feed feedID along with the collections it is part of. The Added field
+// of the returned feed is nil if the user is not subscribed to it.
+func (s *Server) subscription(feedID string) feedly.Feed {
+	subscription := feedly.Feed{ID: feedly.NewString(feedID)}
+
+	if feed, ok := s.uncategorized[feedID]; ok {
+		subscription = *feed
+	}
+
+	for _, collection := range s.sortedCollections() {
+		for _, feed := range collection.Feeds {
+			if *feed.ID != feedID {
+				continue
+			}
+
+			if subscription.Added == nil {
+				subscription = feed
+			}
+
+			subscription.Categories = append(subscription.Categories, feedly.Collection{
+				ID:    collection.ID,
+				Label: collection.Label,
+			})
+		}
+	}
+
+	return subscription
+}
+
+// removeFromCollection removes the feed feedID from collection.
+func (s *Server) removeFromCollection(collection *feedly.Collection, feedID string) {
+	feeds := make([]feedly.Feed, 0, len(collection.Feeds))
+
+	for _, feed := range collection.Feeds {
+		if *feed.ID != feedID {
+			feeds = append(feeds, feed)
+		}
+	}
+
+	collection.Feeds = feeds
+}
@@ -1,6 +1,7 @@
 package feedly
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -11,13 +12,18 @@ import (
 
 // FeedService provides methods for managing feeds.
 type FeedService struct {
-	sling *sling.Sling
+	sling             *sling.Sling
+	multipleGetPolicy *MultipleGetPolicy
+	// search finds the feeds known to Feedly for FeedService.Discover.
+	search *SearchService
 }
 
 // newFeedService returns a new FeedService.
-func newFeedService(sling *sling.Sling) *FeedService {
+func newFeedService(sling *sling.Sling, multipleGetPolicy *MultipleGetPolicy, search *SearchService) *FeedService {
 	return &FeedService{
-		sling: sling,
+		sling:             sling,
+		multipleGetPolicy: multipleGetPolicy,
+		search:            search,
 	}
 }
 
@@ -31,6 +37,7 @@ type Feed struct {
 	AnalyticsEngine             *string                `json:"analyticsEngine,omitempty"`
 	AnalyticsID                 *string                `json:"analyticsId,omitempty"`
 	AverageReadTime             *float64               `json:"averageReadTime,omitempty"`
+	Categories                  []Collection           `json:"categories,omitempty"`
 	ContentType                 *string                `json:"contentType,omitempty"`
 	CoverColor                  *string                `json:"coverColor,omitempty"`
 	CoverURL                    *string                `json:"coverUrl,omitempty"`
@@ -58,6 +65,7 @@ type Feed struct {
 	RelatedTarget               *string                `json:"relatedTarget,omitempty"`
 	RelevanceScore              *float64               `json:"relevanceScore,omitempty"`
 	Score                       *int                   `json:"score,omitempty"`
+	SortID                      *string                `json:"sortid,omitempty"`
 	Sponsored                   *bool                  `json:"sponsored,omitempty"`
 	State                       *string                `json:"state,omitempty"`
 	Subscribers                 *int                   `json:"subscribers,omitempty"`
@@ -84,13 +92,18 @@ type FeedMetadataResponse struct {
 }
 
 // Metadata returns the metadata for a single feed.
-func (s *FeedService) Metadata(feedID string) (*FeedMetadataResponse, *http.Response, error) {
+func (s *FeedService) Metadata(feedID StreamID) (*FeedMetadataResponse, *http.Response, error) {
+	return s.MetadataWithContext(context.Background(), feedID)
+}
+
+// MetadataWithContext is like Metadata but uses ctx to control the lifetime of the request.
+func (s *FeedService) MetadataWithContext(ctx context.Context, feedID StreamID) (*FeedMetadataResponse, *http.Response, error) {
 	encodedResponse := make(map[string]interface{})
 	decodedResponse := new(FeedMetadataResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("feeds/"+url.PathEscape(feedID)).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("feeds/"+url.PathEscape(feedID.String())), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -103,21 +116,37 @@ func (s *FeedService) Metadata(feedID string) (*FeedMetadataResponse, *http.Resp
 
 // FeedMultipleMetadataResponse represents the response from FeedService.MultipleMetadata.
 type FeedMultipleMetadataResponse struct {
+	Failures       []BatchError           `json:"-"`
 	Feeds          []Feed                 `json:"feeds"`
 	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
 }
 
-// MultipleMetadata returns the metadata for a list of feeds.
+// MultipleMetadata returns the metadata for a list of feeds, in the order of feedIDs, dropping duplicate IDs. The feeds
+// are requested in concurrent batches, as configured by the MultipleGetPolicy of the Client. Failures lists the
+// batches that failed. An error is returned if every batch failed, or if the context is done, in which case the
+// batches already fetched are discarded.
 func (s *FeedService) MultipleMetadata(feedIDs []string) (*FeedMultipleMetadataResponse, *http.Response, error) {
-	encodedResponse := make([]map[string]interface{}, 0)
-	decodedResponse := new(FeedMultipleMetadataResponse)
-	apiError := new(APIError)
+	return s.MultipleMetadataWithContext(context.Background(), feedIDs)
+}
 
-	resp, err := s.sling.New().Post("feeds/.mget").BodyJSON(feedIDs).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+// MultipleMetadataWithContext is like MultipleMetadata but uses ctx to control the lifetime of the requests.
+func (s *FeedService) MultipleMetadataWithContext(ctx context.Context, feedIDs []string) (*FeedMultipleMetadataResponse, *http.Response, error) {
+	encodedResponse, failures, resp, err := multipleGet(ctx, feedIDs, s.multipleGetPolicy.feedBatchSize(), s.multipleGetPolicy.workers(), func(ctx context.Context, ids []string) ([]map[string]interface{}, *http.Response, error) {
+		encodedResponse := make([]map[string]interface{}, 0)
+		apiError := new(APIError)
+
+		resp, err := receive(ctx, s.sling.New().Post("feeds/.mget").BodyJSON(ids), &encodedResponse, apiError)
+
+		return encodedResponse, resp, relevantError(resp, err, apiError)
+	})
+	if err != nil {
 		return nil, resp, err
 	}
 
+	decodedResponse := &FeedMultipleMetadataResponse{
+		Failures: failures,
+	}
+
 	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Feeds); err != nil {
 		return nil, resp, err
 	}
@@ -11,7 +11,7 @@ import (
 )
 
 func testFeedServiceMetadata(t *testing.T) {
-	metadataResponse, resp, err := client.Feeds.Metadata(*responseCollections[controlCollectionNames[rand.Intn(len(responseCollections))]].Feeds[0].ID)
+	metadataResponse, resp, err := client.Feeds.Metadata(feedly.StreamID(*responseCollections[controlCollectionNames[rand.Intn(len(responseCollections))]].Feeds[0].ID))
 	if err != nil {
 		t.Errorf("%v", err)
 	}
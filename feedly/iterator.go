@@ -0,0 +1,198 @@
+package feedly
+
+import (
+	"context"
+
+	"github.com/sfanous/go-feedly/pkg/time"
+)
+
+// EntryIteratorOptionalParams are the optional parameters for an EntryIterator.
+type EntryIteratorOptionalParams struct {
+	// MaxItems caps the number of entries returned by the iterator.
+	MaxItems *int
+	// NewerThan skips entries crawled (or published, if the crawl time is unknown) before the given time. It is sent to
+	// Feedly as the newerThan parameter of each request, and the iteration of a stream ranked newest first stops at the
+	// first page reaching an older entry.
+	NewerThan *time.Time
+}
+
+// entryPageFunc fetches the page of a stream starting at continuation (nil for the first page), restricted to the
+// entries newer than newerThan unless it is nil.
+type entryPageFunc func(ctx context.Context, continuation *string, newerThan *time.Time) (*Stream, error)
+
+// EntryIterator iterates over the entries of a stream, transparently following continuations.
+//
+//	iterator := client.Streams.Iterate(feedly.GlobalAll(userID), nil, nil)
+//
+//	for iterator.Next() {
+//		entry := iterator.Entry()
+//		...
+//	}
+//
+//	if err := iterator.Err(); err != nil {
+//		...
+//	}
+type EntryIterator struct {
+	ctx          context.Context
+	fetch        entryPageFunc
+	params       EntryIteratorOptionalParams
+	newestFirst  bool
+	continuation *string
+	entries      []Entry
+	entry        *Entry
+	count        int
+	done         bool
+	err          error
+}
+
+// newEntryIterator returns a new EntryIterator fetching pages with fetch. newestFirst reports whether the pages are
+// ranked newest first, so the iteration can stop at the NewerThan cutoff.
+func newEntryIterator(ctx context.Context, fetch entryPageFunc, optionalParams *EntryIteratorOptionalParams, newestFirst bool) *EntryIterator {
+	if optionalParams == nil {
+		optionalParams = &EntryIteratorOptionalParams{}
+	}
+
+	return &EntryIterator{
+		ctx:         ctx,
+		fetch:       fetch,
+		params:      *optionalParams,
+		newestFirst: newestFirst,
+	}
+}
+
+// Next advances the iterator to the next entry, fetching the next page of the stream when needed. It returns false
+// once the stream is exhausted, MaxItems entries have been returned, or an error occurred.
+func (it *EntryIterator) Next() bool {
+	if it.params.MaxItems != nil && it.count >= *it.params.MaxItems {
+		it.entry = nil
+
+		return false
+	}
+
+	for {
+		for len(it.entries) > 0 {
+			entry := it.entries[0]
+			it.entries = it.entries[1:]
+
+			if !it.isNewEnough(&entry) {
+				continue
+			}
+
+			it.entry = &entry
+			it.count++
+
+			return true
+		}
+
+		if it.done || it.err != nil {
+			it.entry = nil
+
+			return false
+		}
+
+		if err := it.ctx.Err(); err != nil {
+			it.entry = nil
+			it.err = err
+
+			return false
+		}
+
+		stream, err := it.fetch(it.ctx, it.continuation, it.params.NewerThan)
+		if err != nil {
+			it.entry = nil
+			it.err = err
+
+			return false
+		}
+
+		if stream == nil {
+			it.done = true
+
+			continue
+		}
+
+		it.entries = stream.Items
+		it.continuation = stream.Continuation
+		it.done = stream.Continuation == nil || *stream.Continuation == "" || it.reachedNewerThan(stream.Items)
+	}
+}
+
+// Entry returns the current entry. It must only be called after a call to Next returned true.
+func (it *EntryIterator) Entry() *Entry {
+	return it.entry
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *EntryIterator) Err() error {
+	return it.err
+}
+
+// Entries returns a channel receiving the remaining entries of the iterator. The channel is closed once the
+// iteration stops, after which Err reports the error, if any. Cancel the context the iterator was created with to
+// stop a consumer that does not drain the channel: the entries not received yet, including the one being sent, are
+// kept and returned by Next.
+func (it *EntryIterator) Entries() <-chan Entry {
+	entries := make(chan Entry)
+
+	go func() {
+		defer close(entries)
+
+		for it.Next() {
+			select {
+			case entries <- *it.entry:
+			case <-it.ctx.Done():
+				it.entries = append([]Entry{*it.entry}, it.entries...)
+				it.entry = nil
+				it.count--
+				it.err = it.ctx.Err()
+
+				return
+			}
+		}
+	}()
+
+	return entries
+}
+
+// isNewEnough reports whether entry is not older than the NewerThan cutoff of the iterator.
+func (it *EntryIterator) isNewEnough(entry *Entry) bool {
+	if it.params.NewerThan == nil {
+		return true
+	}
+
+	timestamp := entry.Crawled
+	if timestamp == nil {
+		timestamp = entry.Published
+	}
+
+	if timestamp == nil {
+		return true
+	}
+
+	return !timestamp.Time.Before(it.params.NewerThan.Time)
+}
+
+// reachedNewerThan reports whether entries, a page of a stream ranked newest first, contains an entry older than the
+// NewerThan cutoff of the iterator, so the following pages only contain older entries.
+func (it *EntryIterator) reachedNewerThan(entries []Entry) bool {
+	if !it.newestFirst || it.params.NewerThan == nil {
+		return false
+	}
+
+	for i := range entries {
+		if !it.isNewEnough(&entries[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// laterTime returns the later of a and b, ignoring nil values.
+func laterTime(a *time.Time, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.Time.After(a.Time)) {
+		return b
+	}
+
+	return a
+}
@@ -0,0 +1,213 @@
+package feedly_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+	pkgtime "github.com/sfanous/go-feedly/pkg/time"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedStreamServer serves a stream of entries, newest first, in pages of pageSize entries. The entry i was crawled
+// i hours before epoch.
+type pagedStreamServer struct {
+	*httptest.Server
+	epoch    time.Time
+	mu       sync.Mutex
+	queries  []string
+	failPage int
+}
+
+func newPagedStreamServer(t *testing.T, numberOfEntries int, pageSize int) *pagedStreamServer {
+	s := &pagedStreamServer{
+		epoch: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.queries = append(s.queries, r.URL.RawQuery)
+		page := len(s.queries)
+		failPage := s.failPage
+		s.mu.Unlock()
+
+		if page == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"errorId": "id", "errorMessage": "internal error"}`))
+
+			return
+		}
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("continuation"))
+		stream := feedly.Stream{
+			ID: feedly.NewString("feed/https://example.com/rss"),
+		}
+
+		for i := offset; i < offset+pageSize && i < numberOfEntries; i++ {
+			stream.Items = append(stream.Items, feedly.Entry{
+				Crawled: &pkgtime.Time{Time: s.epoch.Add(-time.Duration(i) * time.Hour)},
+				ID:      feedly.NewString(fmt.Sprintf("entry-%d", i)),
+			})
+		}
+
+		if offset+pageSize < numberOfEntries {
+			stream.Continuation = feedly.NewString(strconv.Itoa(offset + pageSize))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(stream)
+	}))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *pagedStreamServer) requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.queries...)
+}
+
+// collectEntryIDs returns the IDs of the remaining entries of iterator.
+func collectEntryIDs(iterator *feedly.EntryIterator) []string {
+	ids := make([]string, 0)
+
+	for iterator.Next() {
+		ids = append(ids, *iterator.Entry().ID)
+	}
+
+	return ids
+}
+
+func TestEntryIterator(t *testing.T) {
+	t.Run("Continuation", func(t *testing.T) {
+		server := newPagedStreamServer(t, 7, 3)
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL))
+
+		iterator := client.Streams.Iterate("feed/https://example.com/rss", nil, nil)
+
+		assert.Equal(t, []string{"entry-0", "entry-1", "entry-2", "entry-3", "entry-4", "entry-5", "entry-6"}, collectEntryIDs(iterator))
+		assert.NoError(t, iterator.Err())
+		assert.Nil(t, iterator.Entry())
+		assert.Equal(t, []string{"", "continuation=3", "continuation=6"}, server.requests())
+		assert.False(t, iterator.Next())
+	})
+
+	t.Run("MaxItems", func(t *testing.T) {
+		server := newPagedStreamServer(t, 10, 3)
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL))
+
+		iterator := client.Streams.Iterate("feed/https://example.com/rss", nil, &feedly.EntryIteratorOptionalParams{
+			MaxItems: feedly.NewInt(4),
+		})
+
+		assert.Equal(t, []string{"entry-0", "entry-1", "entry-2", "entry-3"}, collectEntryIDs(iterator))
+		assert.NoError(t, iterator.Err())
+		assert.Len(t, server.requests(), 2)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		server := newPagedStreamServer(t, 10, 3)
+		server.failPage = 2
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL))
+
+		iterator := client.Streams.Iterate("feed/https://example.com/rss", nil, nil)
+
+		assert.Equal(t, []string{"entry-0", "entry-1", "entry-2"}, collectEntryIDs(iterator))
+
+		var apiError *feedly.APIError
+		require.True(t, errors.As(iterator.Err(), &apiError))
+		assert.Equal(t, http.StatusInternalServerError, apiError.StatusCode)
+		assert.False(t, iterator.Next())
+		assert.Len(t, server.requests(), 2)
+	})
+
+	t.Run("NewerThan", func(t *testing.T) {
+		server := newPagedStreamServer(t, 10, 3)
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL))
+
+		newerThan := &pkgtime.Time{Time: server.epoch.Add(-4 * time.Hour)}
+		iterator := client.Streams.Iterate("feed/https://example.com/rss", nil, &feedly.EntryIteratorOptionalParams{
+			NewerThan: newerThan,
+		})
+
+		assert.Equal(t, []string{"entry-0", "entry-1", "entry-2", "entry-3", "entry-4"}, collectEntryIDs(iterator))
+		assert.NoError(t, iterator.Err())
+
+		// The cutoff is sent with each request, and the page holding entry-5 is the last one requested.
+		requests := server.requests()
+		require.Len(t, requests, 2)
+
+		for _, query := range requests {
+			assert.Contains(t, query, "newerThan="+strconv.FormatInt(pkgtime.UnixMilli(newerThan.Time), 10))
+		}
+	})
+
+	t.Run("NewerThanOldestFirst", func(t *testing.T) {
+		server := newPagedStreamServer(t, 10, 3)
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL))
+
+		ranked := feedly.Oldest
+		iterator := client.Streams.Iterate("feed/https://example.com/rss", &feedly.StreamContentOptionalParams{
+			Ranked: &ranked,
+		}, &feedly.EntryIteratorOptionalParams{
+			NewerThan: &pkgtime.Time{Time: server.epoch.Add(-4 * time.Hour)},
+		})
+
+		// The pages are not ranked newest first, so all of them are requested.
+		assert.Len(t, collectEntryIDs(iterator), 5)
+		assert.Len(t, server.requests(), 4)
+	})
+
+	t.Run("Entries", func(t *testing.T) {
+		server := newPagedStreamServer(t, 5, 2)
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL))
+
+		iterator := client.Streams.Iterate("feed/https://example.com/rss", nil, nil)
+
+		ids := make([]string, 0)
+
+		for entry := range iterator.Entries() {
+			ids = append(ids, *entry.ID)
+		}
+
+		assert.Equal(t, []string{"entry-0", "entry-1", "entry-2", "entry-3", "entry-4"}, ids)
+		assert.NoError(t, iterator.Err())
+	})
+
+	t.Run("EntriesCancelled", func(t *testing.T) {
+		server := newPagedStreamServer(t, 5, 3)
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		iterator := client.Streams.IterateWithContext(ctx, "feed/https://example.com/rss", nil, nil)
+
+		entries := iterator.Entries()
+
+		entry := <-entries
+		assert.Equal(t, "entry-0", *entry.ID)
+
+		// entry-1 is waiting to be received when the context is cancelled. Give the sending goroutine time to notice
+		// the cancellation before receiving again.
+		cancel()
+		time.Sleep(50 * time.Millisecond)
+
+		for range entries {
+			t.Fatal("received an entry after the cancellation")
+		}
+
+		assert.True(t, errors.Is(iterator.Err(), context.Canceled))
+		assert.Equal(t, []string{"entry-1", "entry-2"}, collectEntryIDs(iterator))
+	})
+}
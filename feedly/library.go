@@ -1,6 +1,7 @@
 package feedly
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -48,12 +49,17 @@ type LibraryAliasAvailableResponse struct {
 
 // AliasAvailable checks if an alias is available to be used.
 func (s *LibraryService) AliasAvailable(alias string) (*LibraryAliasAvailableResponse, *http.Response, error) {
+	return s.AliasAvailableWithContext(context.Background(), alias)
+}
+
+// AliasAvailableWithContext is like AliasAvailable but uses ctx to control the lifetime of the request.
+func (s *LibraryService) AliasAvailableWithContext(ctx context.Context, alias string) (*LibraryAliasAvailableResponse, *http.Response, error) {
 	encodedResponse := make(map[string]interface{})
 	decodedResponse := new(LibraryAliasAvailableResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("alias/"+url.PathEscape(alias)).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("alias/"+url.PathEscape(alias)), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -72,12 +78,17 @@ type LibraryCoverResponse struct {
 
 // Cover returns the library cover.
 func (s *LibraryService) Cover() (*LibraryCoverResponse, *http.Response, error) {
+	return s.CoverWithContext(context.Background())
+}
+
+// CoverWithContext is like Cover but uses ctx to control the lifetime of the request.
+func (s *LibraryService) CoverWithContext(ctx context.Context) (*LibraryCoverResponse, *http.Response, error) {
 	encodedResponse := make(map[string]interface{})
 	decodedResponse := new(LibraryCoverResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("library/cover").Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("library/cover"), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -90,11 +101,16 @@ func (s *LibraryService) Cover() (*LibraryCoverResponse, *http.Response, error)
 
 // Delete deletes a library.
 func (s *LibraryService) Delete() (*http.Response, error) {
+	return s.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext is like Delete but uses ctx to control the lifetime of the request.
+func (s *LibraryService) DeleteWithContext(ctx context.Context) (*http.Response, error) {
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Delete("library/cover").Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Delete("library/cover"), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // LibraryDetailsResponse represents the response from LibraryService.Details.
@@ -105,12 +121,17 @@ type LibraryDetailsResponse struct {
 
 // Details returns the library details.
 func (s *LibraryService) Details(alias string) (*LibraryDetailsResponse, *http.Response, error) {
+	return s.DetailsWithContext(context.Background(), alias)
+}
+
+// DetailsWithContext is like Details but uses ctx to control the lifetime of the request.
+func (s *LibraryService) DetailsWithContext(ctx context.Context, alias string) (*LibraryDetailsResponse, *http.Response, error) {
 	encodedResponse := make(map[string]interface{})
 	decodedResponse := new(LibraryDetailsResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("library/"+alias).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("library/"+alias), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -130,12 +151,17 @@ type LibraryLeoIndustriesResponse struct {
 
 // LeoIndustries returns the Leo industries.
 func (s *LibraryService) LeoIndustries() (*LibraryLeoIndustriesResponse, *http.Response, error) {
+	return s.LeoIndustriesWithContext(context.Background())
+}
+
+// LeoIndustriesWithContext is like LeoIndustries but uses ctx to control the lifetime of the request.
+func (s *LibraryService) LeoIndustriesWithContext(ctx context.Context) (*LibraryLeoIndustriesResponse, *http.Response, error) {
 	encodedResponse := make(map[string]interface{})
 	decodedResponse := new(LibraryLeoIndustriesResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("library/leoIndustries").Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("library/leoIndustries"), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -158,12 +184,17 @@ type LibraryListSharedResourcesResponse struct {
 
 // ListSharedResources returns the list of shared resources.
 func (s *LibraryService) ListSharedResources() (*LibraryListSharedResourcesResponse, *http.Response, error) {
+	return s.ListSharedResourcesWithContext(context.Background())
+}
+
+// ListSharedResourcesWithContext is like ListSharedResources but uses ctx to control the lifetime of the request.
+func (s *LibraryService) ListSharedResourcesWithContext(ctx context.Context) (*LibraryListSharedResourcesResponse, *http.Response, error) {
 	encodedResponse := make(map[string]interface{})
 	decodedResponse := new(LibraryListSharedResourcesResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("library/acl").Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("library/acl"), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -175,7 +206,12 @@ func (s *LibraryService) ListSharedResources() (*LibraryListSharedResourcesRespo
 }
 
 // ShareResource shares a resource.
-func (s *LibraryService) ShareResource(collectionID string) (*http.Response, error) {
+func (s *LibraryService) ShareResource(collectionID StreamID) (*http.Response, error) {
+	return s.ShareResourceWithContext(context.Background(), collectionID)
+}
+
+// ShareResourceWithContext is like ShareResource but uses ctx to control the lifetime of the request.
+func (s *LibraryService) ShareResourceWithContext(ctx context.Context, collectionID StreamID) (*http.Response, error) {
 	bodyJSON := &struct {
 		Scope string `json:"scope,omitempty"`
 	}{
@@ -184,18 +220,23 @@ func (s *LibraryService) ShareResource(collectionID string) (*http.Response, err
 
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("library/acl/"+url.PathEscape(collectionID)+"/"+url.PathEscape("global.public")).BodyJSON(bodyJSON).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Get("library/acl/"+url.PathEscape(collectionID.String())+"/"+url.PathEscape("global.public")).BodyJSON(bodyJSON), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // UnshareResource unshares a resource.
-func (s *LibraryService) UnshareResource(collectionID string) (*http.Response, error) {
+func (s *LibraryService) UnshareResource(collectionID StreamID) (*http.Response, error) {
+	return s.UnshareResourceWithContext(context.Background(), collectionID)
+}
+
+// UnshareResourceWithContext is like UnshareResource but uses ctx to control the lifetime of the request.
+func (s *LibraryService) UnshareResourceWithContext(ctx context.Context, collectionID StreamID) (*http.Response, error) {
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Delete("library/acl/"+url.PathEscape(collectionID)+"/"+url.PathEscape("global.public")).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Delete("library/acl/"+url.PathEscape(collectionID.String())+"/"+url.PathEscape("global.public")), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // LibraryUpdateCoverResponse represents the response from LibraryService.UpdateCover.
@@ -206,12 +247,17 @@ type LibraryUpdateCoverResponse struct {
 
 // UpdateCover updates the library cover.
 func (s *LibraryService) UpdateCover(cover *Cover) (*LibraryUpdateCoverResponse, *http.Response, error) {
+	return s.UpdateCoverWithContext(context.Background(), cover)
+}
+
+// UpdateCoverWithContext is like UpdateCover but uses ctx to control the lifetime of the request.
+func (s *LibraryService) UpdateCoverWithContext(ctx context.Context, cover *Cover) (*LibraryUpdateCoverResponse, *http.Response, error) {
 	encodedResponse := make(map[string]interface{})
 	decodedResponse := new(LibraryUpdateCoverResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("library/cover").BodyJSON(cover).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Post("library/cover").BodyJSON(cover), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -129,7 +129,7 @@ func testLibraryServiceListSharedResources(t *testing.T) {
 func testLibraryServiceShareResource(t *testing.T) {
 	librarySharedCollection = *responseCollections[controlCollectionNames[rand.Intn(len(controlCollectionNames))]].ID
 
-	resp, err := client.Library.ShareResource(librarySharedCollection)
+	resp, err := client.Library.ShareResource(feedly.StreamID(librarySharedCollection))
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -141,7 +141,7 @@ func testLibraryServiceShareResource(t *testing.T) {
 }
 
 func testLibraryServiceUnshareResource(t *testing.T) {
-	resp, err := client.Library.UnshareResource(librarySharedCollection)
+	resp, err := client.Library.UnshareResource(feedly.StreamID(librarySharedCollection))
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -1,6 +1,7 @@
 package feedly
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
@@ -58,6 +59,11 @@ type MarkerLatestReadResponse struct {
 
 // LatestRead returns the latest read operations.
 func (s *MarkerService) LatestRead(optionalParams *MarkerLatestReadOptionalParams) (*MarkerLatestReadResponse, *http.Response, error) {
+	return s.LatestReadWithContext(context.Background(), optionalParams)
+}
+
+// LatestReadWithContext is like LatestRead but uses ctx to control the lifetime of the request.
+func (s *MarkerService) LatestReadWithContext(ctx context.Context, optionalParams *MarkerLatestReadOptionalParams) (*MarkerLatestReadResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &MarkerLatestReadOptionalParams{}
 	}
@@ -66,8 +72,8 @@ func (s *MarkerService) LatestRead(optionalParams *MarkerLatestReadOptionalParam
 	decodedResponse := new(MarkerLatestReadResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("markers/reads").QueryStruct(optionalParams).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("markers/reads").QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -91,6 +97,11 @@ type MarkerLatestTaggedResponse struct {
 
 // LatestTagged returns latest tagged entry ids.
 func (s *MarkerService) LatestTagged(optionalParams *MarkerLatestTaggedOptionalParams) (*MarkerLatestTaggedResponse, *http.Response, error) {
+	return s.LatestTaggedWithContext(context.Background(), optionalParams)
+}
+
+// LatestTaggedWithContext is like LatestTagged but uses ctx to control the lifetime of the request.
+func (s *MarkerService) LatestTaggedWithContext(ctx context.Context, optionalParams *MarkerLatestTaggedOptionalParams) (*MarkerLatestTaggedResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &MarkerLatestTaggedOptionalParams{}
 	}
@@ -99,8 +110,8 @@ func (s *MarkerService) LatestTagged(optionalParams *MarkerLatestTaggedOptionalP
 	decodedResponse := new(MarkerLatestTaggedResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("markers/tags").QueryStruct(optionalParams).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("markers/tags").QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -123,6 +134,11 @@ type MarkerMarkOptionalParams struct {
 
 // Mark marks one or more collections, entries, feeds, or tags as read, saved, or unread.
 func (s *MarkerService) Mark(markAction MarkAction, markType MarkType, optionalParams *MarkerMarkOptionalParams) (*http.Response, error) {
+	return s.MarkWithContext(context.Background(), markAction, markType, optionalParams)
+}
+
+// MarkWithContext is like Mark but uses ctx to control the lifetime of the request.
+func (s *MarkerService) MarkWithContext(ctx context.Context, markAction MarkAction, markType MarkType, optionalParams *MarkerMarkOptionalParams) (*http.Response, error) {
 	bodyJSON := &struct {
 		Action MarkAction `json:"action"`
 		MarkerMarkOptionalParams
@@ -162,9 +178,9 @@ func (s *MarkerService) Mark(markAction MarkAction, markType MarkType, optionalP
 
 	apiError := new(APIError)
 
-	resp, err := s.sling.Post("markers").BodyJSON(bodyJSON).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Post("markers").BodyJSON(bodyJSON), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
 
 // MarkerUnreadCountsOptionalParams are the optional parameters for MarkerService.UnreadCounts.
@@ -188,6 +204,11 @@ type MarkerUnreadCountsResponse struct {
 
 // UnreadCounts returns the list of unread counts.
 func (s *MarkerService) UnreadCounts(optionalParams *MarkerUnreadCountsOptionalParams) (*MarkerUnreadCountsResponse, *http.Response, error) {
+	return s.UnreadCountsWithContext(context.Background(), optionalParams)
+}
+
+// UnreadCountsWithContext is like UnreadCounts but uses ctx to control the lifetime of the request.
+func (s *MarkerService) UnreadCountsWithContext(ctx context.Context, optionalParams *MarkerUnreadCountsOptionalParams) (*MarkerUnreadCountsResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &MarkerUnreadCountsOptionalParams{}
 	}
@@ -196,8 +217,8 @@ func (s *MarkerService) UnreadCounts(optionalParams *MarkerUnreadCountsOptionalP
 	decodedResponse := new(MarkerUnreadCountsResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("markers/counts").QueryStruct(optionalParams).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("markers/counts").QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
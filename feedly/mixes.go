@@ -1,6 +1,7 @@
 package feedly
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -38,7 +39,12 @@ type MixMostEngagingResponse struct {
 }
 
 // MostEngaging returns a mix of the most engaging content available in a stream.
-func (s *MixService) MostEngaging(streamID string, optionalParams *MixMostEngagingOptionalParams) (*MixMostEngagingResponse, *http.Response, error) {
+func (s *MixService) MostEngaging(streamID StreamID, optionalParams *MixMostEngagingOptionalParams) (*MixMostEngagingResponse, *http.Response, error) {
+	return s.MostEngagingWithContext(context.Background(), streamID, optionalParams)
+}
+
+// MostEngagingWithContext is like MostEngaging but uses ctx to control the lifetime of the request.
+func (s *MixService) MostEngagingWithContext(ctx context.Context, streamID StreamID, optionalParams *MixMostEngagingOptionalParams) (*MixMostEngagingResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &MixMostEngagingOptionalParams{}
 	}
@@ -47,8 +53,8 @@ func (s *MixService) MostEngaging(streamID string, optionalParams *MixMostEngagi
 	decodedResponse := new(MixMostEngagingResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("mixes/"+url.PathEscape(streamID)+"/contents").QueryStruct(optionalParams).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("mixes/"+url.PathEscape(streamID.String())+"/contents").QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -58,3 +64,34 @@ func (s *MixService) MostEngaging(streamID string, optionalParams *MixMostEngagi
 
 	return decodedResponse, resp, nil
 }
+
+// Iterate returns an EntryIterator over a mix of the most engaging content available in a stream. Mixes are not
+// paginated, so the iterator stops after the entries of a single response.
+func (s *MixService) Iterate(streamID StreamID, optionalParams *MixMostEngagingOptionalParams, iteratorParams *EntryIteratorOptionalParams) *EntryIterator {
+	return s.IterateWithContext(context.Background(), streamID, optionalParams, iteratorParams)
+}
+
+// IterateWithContext is like Iterate but uses ctx to control the lifetime of the request.
+func (s *MixService) IterateWithContext(ctx context.Context, streamID StreamID, optionalParams *MixMostEngagingOptionalParams, iteratorParams *EntryIteratorOptionalParams) *EntryIterator {
+	params := MixMostEngagingOptionalParams{}
+	if optionalParams != nil {
+		params = *optionalParams
+	}
+
+	newerThan := params.NewerThan
+
+	return newEntryIterator(ctx, func(ctx context.Context, continuation *string, iteratorNewerThan *time.Time) (*Stream, error) {
+		params.NewerThan = laterTime(newerThan, iteratorNewerThan)
+
+		mostEngagingResponse, _, err := s.MostEngagingWithContext(ctx, streamID, &params)
+		if err != nil {
+			return nil, err
+		}
+
+		if mostEngagingResponse.Stream != nil {
+			mostEngagingResponse.Stream.Continuation = nil
+		}
+
+		return mostEngagingResponse.Stream, nil
+	}, iteratorParams, false)
+}
@@ -11,7 +11,7 @@ import (
 )
 
 func testMixServiceMostEngaging(t *testing.T, streamID string) {
-	mostEngagingResponse, resp, err := client.Mixes.MostEngaging(streamID, &feedly.MixMostEngagingOptionalParams{
+	mostEngagingResponse, resp, err := client.Mixes.MostEngaging(feedly.StreamID(streamID), &feedly.MixMostEngagingOptionalParams{
 		Backfill:   feedly.NewBool(true),
 		Count:      feedly.NewInt(10),
 		Hours:      feedly.NewInt(24),
@@ -0,0 +1,208 @@
+package feedly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+const (
+	// EntryMultipleContentBatchSize is the default maximum number of entries requested at once by
+	// EntryService.MultipleContent.
+	EntryMultipleContentBatchSize = 1000
+	// FeedMultipleMetadataBatchSize is the default maximum number of feeds requested at once by
+	// FeedService.MultipleMetadata.
+	FeedMultipleMetadataBatchSize = 100
+	// MultipleGetWorkers is the default maximum number of concurrent requests sent by EntryService.MultipleContent and
+	// FeedService.MultipleMetadata.
+	MultipleGetWorkers = 4
+)
+
+// MultipleGetPolicy configures how EntryService.MultipleContent and FeedService.MultipleMetadata split the requested
+// IDs into batches requested concurrently. A field left to zero takes its default.
+type MultipleGetPolicy struct {
+	// EntryBatchSize is the maximum number of entries requested at once. Defaults to EntryMultipleContentBatchSize.
+	EntryBatchSize int
+	// FeedBatchSize is the maximum number of feeds requested at once. Defaults to FeedMultipleMetadataBatchSize.
+	FeedBatchSize int
+	// Workers is the maximum number of concurrent requests. Defaults to MultipleGetWorkers.
+	Workers int
+}
+
+// WithMultipleGetPolicy returns a function that initializes a Client with a multiple get policy.
+func WithMultipleGetPolicy(multipleGetPolicy *MultipleGetPolicy) func(*Client) {
+	return func(c *Client) {
+		c.multipleGetPolicy = multipleGetPolicy
+	}
+}
+
+// entryBatchSize returns the maximum number of entries requested at once.
+func (p *MultipleGetPolicy) entryBatchSize() int {
+	if p == nil || p.EntryBatchSize < 1 {
+		return EntryMultipleContentBatchSize
+	}
+
+	return p.EntryBatchSize
+}
+
+// feedBatchSize returns the maximum number of feeds requested at once.
+func (p *MultipleGetPolicy) feedBatchSize() int {
+	if p == nil || p.FeedBatchSize < 1 {
+		return FeedMultipleMetadataBatchSize
+	}
+
+	return p.FeedBatchSize
+}
+
+// workers returns the maximum number of concurrent requests.
+func (p *MultipleGetPolicy) workers() int {
+	if p == nil || p.Workers < 1 {
+		return MultipleGetWorkers
+	}
+
+	return p.Workers
+}
+
+// BatchError reports the failure of the request of a batch of IDs.
+type BatchError struct {
+	// IDs are the IDs of the batch.
+	IDs []string
+	// Err is the error of the request.
+	Err error
+}
+
+// Error returns a string representation of the error.
+func (e BatchError) Error() string {
+	return fmt.Sprintf("feedly: batch of %d IDs failed: %v", len(e.IDs), e.Err)
+}
+
+// Unwrap returns the error of the request.
+func (e BatchError) Unwrap() error {
+	return e.Err
+}
+
+// multipleGetFetcher requests the items ids and returns them undecoded.
+type multipleGetFetcher func(ctx context.Context, ids []string) ([]map[string]interface{}, *http.Response, error)
+
+// multipleGet requests the items ids with fetch, in batches of at most batchSize IDs sent by at most workers concurrent
+// requests. It returns the fetched items in the order of ids, ignoring duplicate IDs and followed by the items whose ID
+// was not requested, the failed batches, and the response of the last successful batch. An error is returned if every
+// batch failed, or if ctx is done.
+func multipleGet(ctx context.Context, ids []string, batchSize int, workers int, fetch multipleGetFetcher) ([]map[string]interface{}, []BatchError, *http.Response, error) {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	unique := make([]string, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	batches := make([][]string, 0, (len(unique)+batchSize-1)/batchSize)
+
+	for start := 0; start < len(unique); start += batchSize {
+		end := start + batchSize
+		if end > len(unique) {
+			end = len(unique)
+		}
+
+		batches = append(batches, unique[start:end])
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	if workers > len(batches) {
+		workers = len(batches)
+	}
+
+	items := make([][]map[string]interface{}, len(batches))
+	responses := make([]*http.Response, len(batches))
+	errs := make([]error, len(batches))
+
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for index := range indexes {
+				if err := ctx.Err(); err != nil {
+					errs[index] = err
+
+					continue
+				}
+
+				items[index], responses[index], errs[index] = fetch(ctx, batches[index])
+			}
+		}()
+	}
+
+	for index := range batches {
+		indexes <- index
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	byID := make(map[string]map[string]interface{}, len(unique))
+	unmatched := make([]map[string]interface{}, 0)
+	failures := make([]BatchError, 0)
+
+	var resp *http.Response
+
+	for index, batch := range batches {
+		if errs[index] != nil {
+			failures = append(failures, BatchError{
+				IDs: batch,
+				Err: errs[index],
+			})
+
+			continue
+		}
+
+		resp = responses[index]
+
+		for _, item := range items[index] {
+			id, _ := item["id"].(string)
+			if _, ok := seen[id]; !ok {
+				unmatched = append(unmatched, item)
+
+				continue
+			}
+
+			byID[id] = item
+		}
+	}
+
+	// A cancelled call is not a partial success, even if some batches were fetched before the cancellation.
+	if err := ctx.Err(); err != nil {
+		return nil, failures, resp, err
+	}
+
+	if len(batches) > 0 && len(failures) == len(batches) {
+		return nil, failures, responses[len(responses)-1], failures[0].Err
+	}
+
+	ordered := make([]map[string]interface{}, 0, len(byID))
+
+	for _, id := range unique {
+		if item, ok := byID[id]; ok {
+			ordered = append(ordered, item)
+		}
+	}
+
+	return append(ordered, unmatched...), failures, resp, nil
+}
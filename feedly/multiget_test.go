@@ -0,0 +1,124 @@
+package feedly_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingTransport fails the requests whose body contains fail.
+type failingTransport struct {
+	base http.RoundTripper
+	fail string
+}
+
+func (t *failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.Contains(body, []byte(t.fail)) {
+			return nil, errors.New("connection reset")
+		}
+
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// cancellingTransport calls cancel once the first response is received.
+type cancellingTransport struct {
+	base   http.RoundTripper
+	cancel context.CancelFunc
+}
+
+func (t *cancellingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+
+	t.cancel()
+
+	return resp, err
+}
+
+func TestMultipleGet(t *testing.T) {
+	server, entryIDs := newTestServer(t, 2500)
+
+	transport := &countingTransport{base: server.Server.Client().Transport}
+	client := feedly.NewClient(&http.Client{Transport: transport}, feedly.WithAPIBaseURL(server.URL))
+
+	requested := make([]string, 0, len(entryIDs)+1)
+	for i := len(entryIDs) - 1; i >= 0; i-- {
+		requested = append(requested, entryIDs[i])
+	}
+
+	requested = append(requested, entryIDs[len(entryIDs)-1], "unknown")
+
+	multipleContentResponse, _, err := client.Entries.MultipleContent(requested)
+	require.NoError(t, err)
+	assert.Empty(t, multipleContentResponse.Failures)
+	assert.Equal(t, 3, transport.requests)
+	require.Len(t, multipleContentResponse.Entries, len(entryIDs))
+
+	for i, entry := range multipleContentResponse.Entries {
+		assert.Equal(t, requested[i], *entry.ID)
+	}
+
+	feedIDs := make([]string, 0)
+
+	for i := 0; i < 150; i++ {
+		feedID := fmt.Sprintf("feed/https://example.com/%d/rss", i)
+		feedIDs = append(feedIDs, feedID)
+		server.AddFeed(feedly.Feed{ID: feedly.NewString(feedID)})
+	}
+
+	transport.requests = 0
+	client = feedly.NewClient(&http.Client{Transport: transport}, feedly.WithAPIBaseURL(server.URL), feedly.WithMultipleGetPolicy(&feedly.MultipleGetPolicy{
+		Workers: 1,
+	}))
+
+	multipleMetadataResponse, _, err := client.Feeds.MultipleMetadata(feedIDs)
+	require.NoError(t, err)
+	assert.Equal(t, 2, transport.requests)
+	require.Len(t, multipleMetadataResponse.Feeds, len(feedIDs))
+	assert.Equal(t, feedIDs[149], *multipleMetadataResponse.Feeds[149].ID)
+
+	client = feedly.NewClient(&http.Client{Transport: &failingTransport{base: server.Server.Client().Transport, fail: entryIDs[0]}}, feedly.WithAPIBaseURL(server.URL), feedly.WithMultipleGetPolicy(&feedly.MultipleGetPolicy{
+		EntryBatchSize: 3,
+	}))
+
+	multipleContentResponse, _, err = client.Entries.MultipleContent(entryIDs[:10])
+	require.NoError(t, err)
+	require.Len(t, multipleContentResponse.Failures, 1)
+	assert.Equal(t, entryIDs[:3], multipleContentResponse.Failures[0].IDs)
+
+	var failure error = multipleContentResponse.Failures[0]
+	assert.Contains(t, failure.Error(), "connection reset")
+	require.Len(t, multipleContentResponse.Entries, 7)
+	assert.Equal(t, entryIDs[3], *multipleContentResponse.Entries[0].ID)
+
+	_, _, err = client.Entries.MultipleContent(entryIDs[:1])
+	assert.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client = feedly.NewClient(&http.Client{Transport: &cancellingTransport{base: server.Server.Client().Transport, cancel: cancel}}, feedly.WithAPIBaseURL(server.URL), feedly.WithMultipleGetPolicy(&feedly.MultipleGetPolicy{
+		EntryBatchSize: 3,
+		Workers:        1,
+	}))
+
+	multipleContentResponse, _, err = client.Entries.MultipleContentWithContext(ctx, entryIDs[:10])
+	assert.True(t, errors.Is(err, context.Canceled), err)
+	assert.Nil(t, multipleContentResponse)
+}
@@ -0,0 +1,217 @@
+package feedly
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+	"github.com/sfanous/go-feedly/internal/mapstructure"
+	"github.com/sfanous/go-feedly/pkg/time"
+)
+
+// MuteFilterService provides methods for managing mute filters, the keyword rules hiding matching entries.
+type MuteFilterService struct {
+	sling   *sling.Sling
+	profile *ProfileService
+}
+
+// newMuteFilterService returns a new MuteFilterService reading the account limits with profile.
+func newMuteFilterService(sling *sling.Sling, profile *ProfileService) *MuteFilterService {
+	return &MuteFilterService{
+		sling:   sling,
+		profile: profile,
+	}
+}
+
+// MuteFilter is a Feedly mute filter.
+type MuteFilter struct {
+	Created *time.Time `json:"created,omitempty"`
+	// Expires is the time after which the filter no longer applies, nil for a permanent filter.
+	Expires *time.Time `json:"expires,omitempty"`
+	ID      *string    `json:"id,omitempty"`
+	// Keywords are the keywords hiding an entry when any of them matches it.
+	Keywords []string `json:"keywords,omitempty"`
+	// StreamIDs are the streams the filter is scoped to, empty for all the streams of the user.
+	StreamIDs      []string               `json:"streamIds,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// MuteFilterCreateOptionalParams are the optional parameters for MuteFilterService.Create.
+type MuteFilterCreateOptionalParams struct {
+	// Validator, if set, validates the filter before it is sent.
+	Validator *MuteFilterValidator
+}
+
+// MuteFilterCreateResponse represents the response from MuteFilterService.Create.
+type MuteFilterCreateResponse struct {
+	MuteFilter     *MuteFilter            `json:"muteFilter"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Create creates a new mute filter.
+func (s *MuteFilterService) Create(muteFilter *MuteFilter, optionalParams *MuteFilterCreateOptionalParams) (*MuteFilterCreateResponse, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), muteFilter, optionalParams)
+}
+
+// CreateWithContext is like Create but uses ctx to control the lifetime of the request.
+func (s *MuteFilterService) CreateWithContext(ctx context.Context, muteFilter *MuteFilter, optionalParams *MuteFilterCreateOptionalParams) (*MuteFilterCreateResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &MuteFilterCreateOptionalParams{}
+	}
+
+	if optionalParams.Validator != nil {
+		if err := optionalParams.Validator.Validate(muteFilter); err != nil {
+			return nil, nil, err
+		}
+
+		if err := optionalParams.Validator.reserve(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(MuteFilterCreateResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("mutefilters").BodyJSON(muteFilter), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		if optionalParams.Validator != nil {
+			optionalParams.Validator.release()
+		}
+
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.MuteFilter); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// MuteFilterDeleteOptionalParams are the optional parameters for MuteFilterService.Delete.
+type MuteFilterDeleteOptionalParams struct {
+	// Validator, if set, stops counting the filter once it is deleted.
+	Validator *MuteFilterValidator
+}
+
+// Delete deletes an existing mute filter.
+func (s *MuteFilterService) Delete(muteFilterID string, optionalParams *MuteFilterDeleteOptionalParams) (*http.Response, error) {
+	return s.DeleteWithContext(context.Background(), muteFilterID, optionalParams)
+}
+
+// DeleteWithContext is like Delete but uses ctx to control the lifetime of the request.
+func (s *MuteFilterService) DeleteWithContext(ctx context.Context, muteFilterID string, optionalParams *MuteFilterDeleteOptionalParams) (*http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &MuteFilterDeleteOptionalParams{}
+	}
+
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Delete("mutefilters/"+url.PathEscape(muteFilterID)), nil, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return resp, err
+	}
+
+	if optionalParams.Validator != nil {
+		optionalParams.Validator.release()
+	}
+
+	return resp, nil
+}
+
+// MuteFilterListResponse represents the response from MuteFilterService.List.
+type MuteFilterListResponse struct {
+	MuteFilters    []MuteFilter           `json:"muteFilters"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// List returns the list of mute filters.
+func (s *MuteFilterService) List() (*MuteFilterListResponse, *http.Response, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but uses ctx to control the lifetime of the request.
+func (s *MuteFilterService) ListWithContext(ctx context.Context) (*MuteFilterListResponse, *http.Response, error) {
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(MuteFilterListResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("mutefilters"), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.MuteFilters); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// MuteFilterUpdateOptionalParams are the optional parameters for MuteFilterService.Update.
+type MuteFilterUpdateOptionalParams struct {
+	// Validator, if set, validates the filter before it is sent.
+	Validator *MuteFilterValidator
+}
+
+// MuteFilterUpdateResponse represents the response from MuteFilterService.Update.
+type MuteFilterUpdateResponse struct {
+	MuteFilter     *MuteFilter            `json:"muteFilter"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Update replaces the keywords, scope, and expiry of an existing mute filter.
+func (s *MuteFilterService) Update(muteFilterID string, muteFilter *MuteFilter, optionalParams *MuteFilterUpdateOptionalParams) (*MuteFilterUpdateResponse, *http.Response, error) {
+	return s.UpdateWithContext(context.Background(), muteFilterID, muteFilter, optionalParams)
+}
+
+// UpdateWithContext is like Update but uses ctx to control the lifetime of the request.
+func (s *MuteFilterService) UpdateWithContext(ctx context.Context, muteFilterID string, muteFilter *MuteFilter, optionalParams *MuteFilterUpdateOptionalParams) (*MuteFilterUpdateResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &MuteFilterUpdateOptionalParams{}
+	}
+
+	if optionalParams.Validator != nil {
+		if err := optionalParams.Validator.Validate(muteFilter); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(MuteFilterUpdateResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Put("mutefilters/"+url.PathEscape(muteFilterID)).BodyJSON(muteFilter), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.MuteFilter); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// Validator returns a MuteFilterValidator enforcing the limits of the account, based on the profile and the existing
+// mute filters of the user.
+func (s *MuteFilterService) Validator() (*MuteFilterValidator, error) {
+	return s.ValidatorWithContext(context.Background())
+}
+
+// ValidatorWithContext is like Validator but uses ctx to control the lifetime of the requests.
+func (s *MuteFilterService) ValidatorWithContext(ctx context.Context) (*MuteFilterValidator, error) {
+	profileResponse, _, err := s.profile.ListWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	listResponse, _, err := s.ListWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMuteFilterValidator(profileResponse.Profile, listResponse.MuteFilters), nil
+}
@@ -0,0 +1,122 @@
+package feedly_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/sfanous/go-feedly/feedly/feedlytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMuteFilters(t *testing.T) {
+	server, _ := newTestServer(t, 0)
+	client := server.Client()
+
+	validator, err := client.MuteFilters.Validator()
+	require.NoError(t, err)
+	maxMuteFilters, ok := validator.MaxMuteFilters()
+	require.True(t, ok)
+	assert.Equal(t, feedlytest.DefaultMaxMuteFilters, maxMuteFilters)
+
+	_, _, err = client.MuteFilters.Create(&feedly.MuteFilter{}, &feedly.MuteFilterCreateOptionalParams{
+		Validator: validator,
+	})
+	assert.Error(t, err)
+
+	_, _, err = client.MuteFilters.Create(&feedly.MuteFilter{
+		Keywords:  []string{"sports"},
+		StreamIDs: []string{"not a stream"},
+	}, &feedly.MuteFilterCreateOptionalParams{
+		Validator: validator,
+	})
+	assert.Error(t, err)
+
+	muteFilterIDs := make([]string, 0, feedlytest.DefaultMaxMuteFilters)
+
+	for i := 0; i < feedlytest.DefaultMaxMuteFilters; i++ {
+		createResponse, _, err := client.MuteFilters.Create(&feedly.MuteFilter{
+			Keywords:  []string{"sports"},
+			StreamIDs: []string{testFeedID},
+		}, &feedly.MuteFilterCreateOptionalParams{
+			Validator: validator,
+		})
+		require.NoError(t, err)
+
+		muteFilterIDs = append(muteFilterIDs, *createResponse.MuteFilter.ID)
+	}
+
+	_, resp, err := client.MuteFilters.Create(&feedly.MuteFilter{
+		Keywords: []string{"weather"},
+	}, &feedly.MuteFilterCreateOptionalParams{
+		Validator: validator,
+	})
+	assert.True(t, errors.Is(err, feedly.ErrMuteFilterLimitExceeded))
+	assert.Nil(t, resp)
+
+	// The limit only applies to creations, so a valid filter still passes validation.
+	assert.NoError(t, validator.Validate(&feedly.MuteFilter{
+		Keywords: []string{"weather"},
+	}))
+
+	updateResponse, _, err := client.MuteFilters.Update(muteFilterIDs[0], &feedly.MuteFilter{
+		Keywords: []string{"sports", "weather"},
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sports", "weather"}, updateResponse.MuteFilter.Keywords)
+
+	assert.Equal(t, feedlytest.DefaultMaxMuteFilters, validator.Count())
+
+	_, err = client.MuteFilters.Delete(muteFilterIDs[1], &feedly.MuteFilterDeleteOptionalParams{
+		Validator: validator,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, feedlytest.DefaultMaxMuteFilters-1, validator.Count())
+
+	listResponse, _, err := client.MuteFilters.List()
+	require.NoError(t, err)
+	assert.Len(t, listResponse.MuteFilters, feedlytest.DefaultMaxMuteFilters-1)
+
+	_, err = client.MuteFilters.Delete(muteFilterIDs[1], &feedly.MuteFilterDeleteOptionalParams{
+		Validator: validator,
+	})
+	assert.True(t, errors.Is(err, feedly.ErrNotFound))
+	assert.Equal(t, feedlytest.DefaultMaxMuteFilters-1, validator.Count())
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, 2)
+
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, _, err := client.MuteFilters.Create(&feedly.MuteFilter{
+				Keywords: []string{"weather"},
+			}, &feedly.MuteFilterCreateOptionalParams{
+				Validator: validator,
+			})
+			errs <- err
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	exceeded := 0
+
+	for err := range errs {
+		if errors.Is(err, feedly.ErrMuteFilterLimitExceeded) {
+			exceeded++
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+
+	assert.Equal(t, 1, exceeded)
+	assert.Equal(t, feedlytest.DefaultMaxMuteFilters, validator.Count())
+}
@@ -0,0 +1,110 @@
+package feedly
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrMuteFilterLimitExceeded is returned by MuteFilterService.Create, using a MuteFilterValidator, for a mute filter
+// that would exceed the maximum number of mute filters of the account.
+var ErrMuteFilterLimitExceeded = errors.New("feedly: mute filter limit exceeded")
+
+// MuteFilterValidator validates mute filters before they are sent, so invalid filters are rejected without a request.
+// A MuteFilterValidator is safe for concurrent use by multiple goroutines.
+type MuteFilterValidator struct {
+	// maxMuteFilters is the maximum number of mute filters of the account, nil if unknown.
+	maxMuteFilters *int
+
+	mu    sync.Mutex
+	count int
+}
+
+// NewMuteFilterValidator returns a MuteFilterValidator enforcing the account limits of profile, given the existing
+// mute filters of the user.
+func NewMuteFilterValidator(profile *Profile, existing []MuteFilter) *MuteFilterValidator {
+	v := &MuteFilterValidator{
+		count: len(existing),
+	}
+
+	if profile != nil && profile.AccountLimits != nil {
+		if maxMuteFilters := profile.AccountLimits.MaxMuteFilters; maxMuteFilters != nil {
+			v.maxMuteFilters = NewInt(*maxMuteFilters)
+		}
+	}
+
+	return v
+}
+
+// MaxMuteFilters returns the maximum number of mute filters of the account, and whether it is known.
+func (v *MuteFilterValidator) MaxMuteFilters() (int, bool) {
+	if v.maxMuteFilters == nil {
+		return 0, false
+	}
+
+	return *v.maxMuteFilters, true
+}
+
+// Validate returns an error if muteFilter has no keywords, an empty keyword, an unrecognized scope stream ID, or an
+// expiry in the past. The limit of the account is not checked here, but by MuteFilterService.Create, which counts the
+// filter in the same step, so concurrent creations cannot exceed the limit together.
+func (v *MuteFilterValidator) Validate(muteFilter *MuteFilter) error {
+	if muteFilter == nil || len(muteFilter.Keywords) == 0 {
+		return errors.New("feedly: mute filter has no keywords")
+	}
+
+	for _, keyword := range muteFilter.Keywords {
+		if strings.TrimSpace(keyword) == "" {
+			return errors.New("feedly: mute filter has an empty keyword")
+		}
+	}
+
+	for _, streamID := range muteFilter.StreamIDs {
+		if _, err := ParseStreamID(streamID); err != nil {
+			return fmt.Errorf("feedly: mute filter scope: %w", err)
+		}
+	}
+
+	if muteFilter.Expires != nil && !muteFilter.Expires.Time.After(time.Now()) {
+		return fmt.Errorf("feedly: mute filter expired at %s", muteFilter.Expires.Time.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// Count returns the number of existing mute filters. It is incremented by every successful MuteFilterService.Create,
+// and decremented by every successful MuteFilterService.Delete, using the validator.
+func (v *MuteFilterValidator) Count() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.count
+}
+
+// reserve counts a mute filter about to be created, returning an error matching ErrMuteFilterLimitExceeded if it would
+// exceed the limit of the account. Checking and counting at once keeps concurrent creations from exceeding the limit
+// together.
+func (v *MuteFilterValidator) reserve() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.maxMuteFilters != nil && v.count >= *v.maxMuteFilters {
+		return fmt.Errorf("%w: the account allows %d mute filters", ErrMuteFilterLimitExceeded, *v.maxMuteFilters)
+	}
+
+	v.count++
+
+	return nil
+}
+
+// release stops counting a mute filter that was deleted, or whose creation failed.
+func (v *MuteFilterValidator) release() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.count > 0 {
+		v.count--
+	}
+}
@@ -1,6 +1,7 @@
 package feedly
 
 import (
+	"context"
 	"encoding/xml"
 	"io"
 	"net/http"
@@ -76,11 +77,16 @@ type OPMLExportResponse struct {
 
 // Export exports the user’s subscriptions.
 func (s *OPMLService) Export() (*OPMLExportResponse, *http.Response, error) {
+	return s.ExportWithContext(context.Background())
+}
+
+// ExportWithContext is like Export but uses ctx to control the lifetime of the request.
+func (s *OPMLService) ExportWithContext(ctx context.Context) (*OPMLExportResponse, *http.Response, error) {
 	decodedResponse := new(OPMLExportResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("opml").ResponseDecoder(decoders.XMLDecoder{}).Receive(&decodedResponse.OPML, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("opml").ResponseDecoder(apiErrorDecoder{decoder: decoders.XMLDecoder{}}), &decodedResponse.OPML, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -89,9 +95,14 @@ func (s *OPMLService) Export() (*OPMLExportResponse, *http.Response, error) {
 
 // Import imports the user's subscriptions.
 func (s *OPMLService) Import(opml io.Reader) (*http.Response, error) {
+	return s.ImportWithContext(context.Background(), opml)
+}
+
+// ImportWithContext is like Import but uses ctx to control the lifetime of the request.
+func (s *OPMLService) ImportWithContext(ctx context.Context, opml io.Reader) (*http.Response, error) {
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("opml").Body(opml).Set("Content-Type", "text/xml").Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Post("opml").Body(opml).Set("Content-Type", "text/xml"), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
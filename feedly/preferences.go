@@ -1,6 +1,7 @@
 package feedly
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
@@ -26,11 +27,16 @@ type PreferenceListResponse struct {
 
 // List returns the application specific preferences.
 func (s *PreferenceService) List() (*PreferenceListResponse, *http.Response, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but uses ctx to control the lifetime of the request.
+func (s *PreferenceService) ListWithContext(ctx context.Context) (*PreferenceListResponse, *http.Response, error) {
 	decodedResponse := new(PreferenceListResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("preferences").Receive(&decodedResponse.Preferences, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("preferences"), &decodedResponse.Preferences, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -39,9 +45,14 @@ func (s *PreferenceService) List() (*PreferenceListResponse, *http.Response, err
 
 // Update update the preferences of the user.
 func (s *PreferenceService) Update(preferences map[string]string) (*http.Response, error) {
+	return s.UpdateWithContext(context.Background(), preferences)
+}
+
+// UpdateWithContext is like Update but uses ctx to control the lifetime of the request.
+func (s *PreferenceService) UpdateWithContext(ctx context.Context, preferences map[string]string) (*http.Response, error) {
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("preferences").BodyJSON(preferences).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Post("preferences").BodyJSON(preferences), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
@@ -0,0 +1,202 @@
+package feedly
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+	"github.com/sfanous/go-feedly/internal/mapstructure"
+	"github.com/sfanous/go-feedly/pkg/time"
+)
+
+// PriorityService provides methods for managing Leo priorities, the rules Leo uses to prioritise the entries of a
+// collection.
+type PriorityService struct {
+	sling *sling.Sling
+}
+
+// newPriorityService returns a new PriorityService.
+func newPriorityService(sling *sling.Sling) *PriorityService {
+	return &PriorityService{
+		sling: sling,
+	}
+}
+
+// PriorityActionType is the type of a PriorityAction.
+type PriorityActionType string
+
+const (
+	MarkAsReadPriorityAction  PriorityActionType = "markAsRead"
+	PrioritizePriorityAction  PriorityActionType = "prioritize"
+	SaveToBoardPriorityAction PriorityActionType = "saveToBoard"
+)
+
+// Priority is a Leo priority: entries of the collection StreamID matching all of its layers are acted upon by all of
+// its actions.
+type Priority struct {
+	Actions        []PriorityAction       `json:"actions,omitempty"`
+	Active         *bool                  `json:"active,omitempty"`
+	Created        *time.Time             `json:"created,omitempty"`
+	ID             *string                `json:"id,omitempty"`
+	Label          *string                `json:"label,omitempty"`
+	Layers         []PriorityLayer        `json:"layers,omitempty"`
+	StreamID       *string                `json:"streamId,omitempty"`
+	Updated        *time.Time             `json:"updated,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// PriorityAction is what Leo does with the entries matching a Priority.
+type PriorityAction struct {
+	// StreamID is the board entries are saved to, for a SaveToBoardPriorityAction.
+	StreamID       *string                `json:"streamId,omitempty"`
+	Type           PriorityActionType     `json:"type,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// PriorityLayer is a condition of a Priority, matched by an entry mentioning any of its parts.
+type PriorityLayer struct {
+	Parts []PriorityPart `json:"parts,omitempty"`
+	// Salience is how prominently a part must be mentioned, such as "about" or "mention".
+	Salience       *string                `json:"salience,omitempty"`
+	Type           *string                `json:"type,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// PriorityPart is a topic, entity, or keyword of a PriorityLayer.
+type PriorityPart struct {
+	ID             *string                `json:"id,omitempty"`
+	Label          *string                `json:"label,omitempty"`
+	Type           *string                `json:"type,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// EntryPriority is a Priority an entry was prioritised by.
+type EntryPriority struct {
+	ActionTimestamp *time.Time `json:"actionTimestamp,omitempty"`
+	ID              *string    `json:"id,omitempty"`
+	Label           *string    `json:"label,omitempty"`
+	// Layers are the layers of the priority along with the parts the entry matched, explaining why it was
+	// prioritised.
+	Layers         []PriorityLayer        `json:"layers,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// PriorityCreateResponse represents the response from PriorityService.Create.
+type PriorityCreateResponse struct {
+	Priority       *Priority              `json:"priority"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Create creates a new priority on the collection collectionID.
+func (s *PriorityService) Create(collectionID StreamID, priority *Priority) (*PriorityCreateResponse, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), collectionID, priority)
+}
+
+// CreateWithContext is like Create but uses ctx to control the lifetime of the request.
+func (s *PriorityService) CreateWithContext(ctx context.Context, collectionID StreamID, priority *Priority) (*PriorityCreateResponse, *http.Response, error) {
+	if priority == nil {
+		return nil, nil, errors.New("feedly: priority is nil")
+	}
+
+	body := *priority
+	body.StreamID = NewString(collectionID.String())
+
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(PriorityCreateResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("priorities").BodyJSON(&body), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Priority); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// Delete deletes an existing priority.
+func (s *PriorityService) Delete(priorityID string) (*http.Response, error) {
+	return s.DeleteWithContext(context.Background(), priorityID)
+}
+
+// DeleteWithContext is like Delete but uses ctx to control the lifetime of the request.
+func (s *PriorityService) DeleteWithContext(ctx context.Context, priorityID string) (*http.Response, error) {
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Delete("priorities/"+url.PathEscape(priorityID)), nil, apiError)
+
+	return resp, relevantError(resp, err, apiError)
+}
+
+// PriorityListResponse represents the response from PriorityService.List.
+type PriorityListResponse struct {
+	Priorities     []Priority             `json:"priorities"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// List returns the list of priorities on the collection collectionID.
+func (s *PriorityService) List(collectionID StreamID) (*PriorityListResponse, *http.Response, error) {
+	return s.ListWithContext(context.Background(), collectionID)
+}
+
+// ListWithContext is like List but uses ctx to control the lifetime of the request.
+func (s *PriorityService) ListWithContext(ctx context.Context, collectionID StreamID) (*PriorityListResponse, *http.Response, error) {
+	queryParams := struct {
+		StreamID string `url:"streamId"`
+	}{
+		StreamID: collectionID.String(),
+	}
+
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(PriorityListResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("priorities").QueryStruct(queryParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Priorities); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// PriorityUpdateResponse represents the response from PriorityService.Update.
+type PriorityUpdateResponse struct {
+	Priority       *Priority              `json:"priority"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Update replaces the label, layers, and actions of an existing priority, and activates or deactivates it.
+func (s *PriorityService) Update(priorityID string, priority *Priority) (*PriorityUpdateResponse, *http.Response, error) {
+	return s.UpdateWithContext(context.Background(), priorityID, priority)
+}
+
+// UpdateWithContext is like Update but uses ctx to control the lifetime of the request.
+func (s *PriorityService) UpdateWithContext(ctx context.Context, priorityID string, priority *Priority) (*PriorityUpdateResponse, *http.Response, error) {
+	if priority == nil {
+		return nil, nil, errors.New("feedly: priority is nil")
+	}
+
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(PriorityUpdateResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Put("priorities/"+url.PathEscape(priorityID)).BodyJSON(priority), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Priority); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
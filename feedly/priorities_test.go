@@ -0,0 +1,88 @@
+package feedly_test
+
+import (
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorities(t *testing.T) {
+	server, _ := newTestServer(t, 0)
+	client := server.Client()
+
+	entryIDs := server.AddEntries(testFeedID,
+		feedly.Entry{Title: feedly.NewString("Ransomware hits a hospital")},
+		feedly.Entry{Title: feedly.NewString("Weather forecast")},
+	)
+
+	createResponse, _, err := client.Collections.Create("security", &feedly.CollectionCreateOptionalParams{
+		Feeds: []feedly.Feed{{ID: feedly.NewString(testFeedID)}},
+	})
+	require.NoError(t, err)
+
+	collectionID := *createResponse.Collections[0].ID
+
+	_, resp, err := client.Priorities.Create(feedly.StreamID(collectionID), nil)
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	priorityResponse, _, err := client.Priorities.Create(feedly.StreamID(collectionID), &feedly.Priority{
+		Actions: []feedly.PriorityAction{{Type: feedly.PrioritizePriorityAction}},
+		Label:   feedly.NewString("Threats"),
+		Layers: []feedly.PriorityLayer{{
+			Parts: []feedly.PriorityPart{
+				{Label: feedly.NewString("malware")},
+				{Label: feedly.NewString("ransomware")},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, priorityResponse.Priority.ID)
+	assert.Equal(t, collectionID, *priorityResponse.Priority.StreamID)
+	assert.True(t, *priorityResponse.Priority.Active)
+
+	priorityID := *priorityResponse.Priority.ID
+
+	listResponse, _, err := client.Priorities.List(feedly.StreamID(collectionID))
+	require.NoError(t, err)
+	require.Len(t, listResponse.Priorities, 1)
+	assert.Equal(t, feedly.PrioritizePriorityAction, listResponse.Priorities[0].Actions[0].Type)
+
+	contentResponse, _, err := client.Entries.Content(entryIDs[0])
+	require.NoError(t, err)
+	require.Len(t, contentResponse.Entries[0].Priorities, 1)
+
+	entryPriority := contentResponse.Entries[0].Priorities[0]
+	assert.Equal(t, priorityID, *entryPriority.ID)
+	require.Len(t, entryPriority.Layers, 1)
+	require.Len(t, entryPriority.Layers[0].Parts, 1)
+	assert.Equal(t, "ransomware", *entryPriority.Layers[0].Parts[0].Label)
+
+	contentResponse, _, err = client.Entries.Content(entryIDs[1])
+	require.NoError(t, err)
+	assert.Empty(t, contentResponse.Entries[0].Priorities)
+
+	_, resp, err = client.Priorities.Update(priorityID, nil)
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	updated := listResponse.Priorities[0]
+	updated.Active = feedly.NewBool(false)
+
+	updateResponse, _, err := client.Priorities.Update(priorityID, &updated)
+	require.NoError(t, err)
+	assert.False(t, *updateResponse.Priority.Active)
+
+	contentResponse, _, err = client.Entries.Content(entryIDs[0])
+	require.NoError(t, err)
+	assert.Empty(t, contentResponse.Entries[0].Priorities)
+
+	_, err = client.Priorities.Delete(priorityID)
+	require.NoError(t, err)
+
+	listResponse, _, err = client.Priorities.List(feedly.StreamID(collectionID))
+	require.NoError(t, err)
+	assert.Empty(t, listResponse.Priorities)
+}
@@ -1,6 +1,7 @@
 package feedly
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
@@ -85,12 +86,17 @@ type ProfileListResponse struct {
 
 // List returns the profile of the user.
 func (s *ProfileService) List() (*ProfileListResponse, *http.Response, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but uses ctx to control the lifetime of the request.
+func (s *ProfileService) ListWithContext(ctx context.Context) (*ProfileListResponse, *http.Response, error) {
 	encodedResponse := make(map[string]interface{})
 	decodedResponse := new(ProfileListResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("profile").Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("profile"), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -103,9 +109,14 @@ func (s *ProfileService) List() (*ProfileListResponse, *http.Response, error) {
 
 // Update updates the profile of the user.
 func (s *ProfileService) Update(profile *Profile) (*http.Response, error) {
+	return s.UpdateWithContext(context.Background(), profile)
+}
+
+// UpdateWithContext is like Update but uses ctx to control the lifetime of the request.
+func (s *ProfileService) UpdateWithContext(ctx context.Context, profile *Profile) (*http.Response, error) {
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Post("profile").BodyJSON(profile).Receive(nil, apiError)
+	resp, err := receive(ctx, s.sling.New().Post("profile").BodyJSON(profile), nil, apiError)
 
-	return resp, relevantError(err, apiError)
+	return resp, relevantError(resp, err, apiError)
 }
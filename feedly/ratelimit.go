@@ -0,0 +1,184 @@
+package feedly
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit represents the API rate limit reported by Feedly in the X-RateLimit-* response headers.
+// https://developer.feedly.com/cloud/#api-rate-limiting
+type RateLimit struct {
+	// Count is the number of requests made in the current window.
+	Count int
+	// Limit is the number of requests allowed in the current window.
+	Limit int
+	// Reset is the time at which the current window ends.
+	Reset time.Time
+}
+
+// Remaining returns the number of requests that can still be made in the current window.
+func (r RateLimit) Remaining() int {
+	if r.Count >= r.Limit {
+		return 0
+	}
+
+	return r.Limit - r.Count
+}
+
+// ParseRateLimit returns the RateLimit reported in the headers of resp, or nil if resp does not report any.
+func ParseRateLimit(resp *http.Response) *RateLimit {
+	if resp == nil {
+		return nil
+	}
+
+	count, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Count"))
+	if err != nil {
+		return nil
+	}
+
+	limit, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return nil
+	}
+
+	rateLimit := &RateLimit{
+		Count: count,
+		Limit: limit,
+	}
+
+	if reset, err := strconv.ParseFloat(resp.Header.Get("X-RateLimit-Reset"), 64); err == nil {
+		rateLimit.Reset = time.Now().Add(time.Duration(reset * float64(time.Second)))
+	}
+
+	return rateLimit
+}
+
+// WithRateLimitThrottling returns a function that initializes a Client which spaces out its requests so the
+// remaining quota reported by Feedly lasts until the end of the current window. Once the quota is exhausted, requests
+// are held back until the reset and then spread over the next window. The throttling is shared by all goroutines using
+// the Client.
+func WithRateLimitThrottling() func(*Client) {
+	return func(c *Client) {
+		c.rateLimitState.throttle = true
+	}
+}
+
+// RateLimit returns a snapshot of the latest rate limit reported by Feedly, or nil if none has been reported yet.
+func (c *Client) RateLimit() *RateLimit {
+	return c.rateLimitState.snapshot()
+}
+
+// rateLimitState tracks the latest rate limit reported by Feedly.
+type rateLimitState struct {
+	mu        sync.Mutex
+	rateLimit *RateLimit
+	next      time.Time
+	throttle  bool
+	// window is the longest time until a reset reported by Feedly, an estimate of the length of a window.
+	window time.Duration
+}
+
+// snapshot returns a copy of the latest rate limit.
+func (s *rateLimitState) snapshot() *RateLimit {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.rateLimit == nil {
+		return nil
+	}
+
+	rateLimit := *s.rateLimit
+
+	return &rateLimit
+}
+
+// update records the rate limit reported in resp, if any.
+func (s *rateLimitState) update(resp *http.Response) {
+	rateLimit := ParseRateLimit(resp)
+	if rateLimit == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimit = rateLimit
+
+	if window := time.Until(rateLimit.Reset); window > s.window {
+		s.window = window
+	}
+}
+
+// reserve reserves a slot for a request and returns how long the request must wait before being sent.
+func (s *rateLimitState) reserve(now time.Time) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.rateLimit == nil || s.rateLimit.Reset.IsZero() {
+		return 0
+	}
+
+	start := now
+	if s.next.After(start) {
+		start = s.next
+	}
+
+	remaining := s.rateLimit.Remaining()
+
+	// Once the quota is exhausted, or the window is over but Feedly has not reported the next one yet, the requests
+	// are spread over the next window instead of all being sent at the reset.
+	if remaining == 0 || !start.Before(s.rateLimit.Reset) {
+		if start.Before(s.rateLimit.Reset) {
+			start = s.rateLimit.Reset
+		}
+
+		if s.rateLimit.Limit > 0 {
+			s.next = start.Add(s.window / time.Duration(s.rateLimit.Limit))
+		} else {
+			s.next = start
+		}
+
+		return start.Sub(now)
+	}
+
+	// Account for the request locally until Feedly reports an up to date count.
+	s.rateLimit.Count++
+	s.next = start.Add(s.rateLimit.Reset.Sub(start) / time.Duration(remaining))
+
+	return start.Sub(now)
+}
+
+// rateLimitTransport is an http.RoundTripper that records the rate limit reported by Feedly and optionally throttles
+// requests accordingly.
+type rateLimitTransport struct {
+	base  http.RoundTripper
+	state *rateLimitState
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.state.throttle {
+		if delay := t.state.reserve(time.Now()); delay > 0 {
+			timer := time.NewTimer(delay)
+
+			select {
+			case <-req.Context().Done():
+				timer.Stop()
+
+				return nil, req.Context().Err()
+			case <-timer.C:
+			}
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	t.state.update(resp)
+
+	return resp, nil
+}
@@ -0,0 +1,168 @@
+package feedly_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	for name, test := range map[string]struct {
+		header   map[string]string
+		expected *feedly.RateLimit
+		reset    time.Duration
+	}{
+		"Complete": {
+			header:   map[string]string{"X-RateLimit-Count": "10", "X-RateLimit-Limit": "250", "X-RateLimit-Reset": "60"},
+			expected: &feedly.RateLimit{Count: 10, Limit: 250},
+			reset:    time.Minute,
+		},
+		"FractionalReset": {
+			header:   map[string]string{"X-RateLimit-Count": "1", "X-RateLimit-Limit": "2", "X-RateLimit-Reset": "0.5"},
+			expected: &feedly.RateLimit{Count: 1, Limit: 2},
+			reset:    500 * time.Millisecond,
+		},
+		"WithoutReset": {
+			header:   map[string]string{"X-RateLimit-Count": "1", "X-RateLimit-Limit": "2"},
+			expected: &feedly.RateLimit{Count: 1, Limit: 2},
+		},
+		"WithoutCount": {
+			header: map[string]string{"X-RateLimit-Limit": "2"},
+		},
+		"Invalid": {
+			header: map[string]string{"X-RateLimit-Count": "one", "X-RateLimit-Limit": "2"},
+		},
+	} {
+		resp := &http.Response{Header: make(http.Header)}
+
+		for key, value := range test.header {
+			resp.Header.Set(key, value)
+		}
+
+		rateLimit := feedly.ParseRateLimit(resp)
+		if test.expected == nil {
+			assert.Nil(t, rateLimit, name)
+
+			continue
+		}
+
+		require.NotNil(t, rateLimit, name)
+		assert.Equal(t, test.expected.Count, rateLimit.Count, name)
+		assert.Equal(t, test.expected.Limit, rateLimit.Limit, name)
+
+		if test.reset == 0 {
+			assert.True(t, rateLimit.Reset.IsZero(), name)
+		} else {
+			assert.WithinDuration(t, time.Now().Add(test.reset), rateLimit.Reset, time.Second, name)
+		}
+	}
+
+	assert.Nil(t, feedly.ParseRateLimit(nil))
+	assert.Equal(t, 0, feedly.RateLimit{Count: 3, Limit: 2}.Remaining())
+	assert.Equal(t, 2, feedly.RateLimit{Count: 3, Limit: 5}.Remaining())
+}
+
+// rateLimitServer reports an exhausted rate limit of limit requests, resetting after reset, and records the time at
+// which each request is received.
+type rateLimitServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	received []time.Time
+}
+
+func newRateLimitServer(t *testing.T, limit string, reset string) *rateLimitServer {
+	s := &rateLimitServer{}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.received = append(s.received, time.Now())
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Count", limit)
+		w.Header().Set("X-RateLimit-Limit", limit)
+		w.Header().Set("X-RateLimit-Reset", reset)
+		_, _ = w.Write([]byte(`{"id": "user"}`))
+	}))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func TestClientRateLimit(t *testing.T) {
+	server := newRateLimitServer(t, "4", "60")
+	client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL))
+
+	assert.Nil(t, client.RateLimit())
+
+	_, _, err := client.Profile.List()
+	require.NoError(t, err)
+
+	rateLimit := client.RateLimit()
+	require.NotNil(t, rateLimit)
+	assert.Equal(t, 4, rateLimit.Count)
+	assert.Equal(t, 4, rateLimit.Limit)
+	assert.Equal(t, 0, rateLimit.Remaining())
+
+	// The snapshot is a copy.
+	rateLimit.Count = 0
+	assert.Equal(t, 4, client.RateLimit().Count)
+
+	// Without throttling, an exhausted quota does not delay requests.
+	start := time.Now()
+
+	_, _, err = client.Profile.List()
+	require.NoError(t, err)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+}
+
+func TestRateLimitThrottling(t *testing.T) {
+	// 4 requests every 200ms, so requests are spaced out by 50ms once the quota is exhausted.
+	server := newRateLimitServer(t, "4", "0.2")
+	client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL), feedly.WithRateLimitThrottling())
+
+	start := time.Now()
+
+	_, _, err := client.Profile.List()
+	require.NoError(t, err)
+
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, _, err := client.Profile.List()
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	require.Len(t, server.received, 4)
+
+	received := server.received[1:]
+	sort.Slice(received, func(i int, j int) bool {
+		return received[i].Before(received[j])
+	})
+
+	// The first waiting request is sent at the reset, the others are not all sent at the same time.
+	assert.GreaterOrEqual(t, int64(received[0].Sub(start)), int64(150*time.Millisecond))
+
+	for i := 1; i < len(received); i++ {
+		assert.GreaterOrEqual(t, int64(received[i].Sub(received[i-1])), int64(40*time.Millisecond))
+	}
+}
@@ -1,6 +1,7 @@
 package feedly
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
@@ -53,6 +54,11 @@ type RecommendationTopicResponse struct {
 
 // Topic returns recommended feeds.
 func (s *RecommendationService) Topic(query string, locale string, optionalParams *RecommendationTopicOptionalParams) (*RecommendationTopicResponse, *http.Response, error) {
+	return s.TopicWithContext(context.Background(), query, locale, optionalParams)
+}
+
+// TopicWithContext is like Topic but uses ctx to control the lifetime of the request.
+func (s *RecommendationService) TopicWithContext(ctx context.Context, query string, locale string, optionalParams *RecommendationTopicOptionalParams) (*RecommendationTopicResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &RecommendationTopicOptionalParams{}
 	}
@@ -69,8 +75,8 @@ func (s *RecommendationService) Topic(query string, locale string, optionalParam
 	decodedResponse := new(RecommendationTopicResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("recommendations/topics").QueryStruct(requiredParams).QueryStruct(optionalParams).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("recommendations/topics").QueryStruct(requiredParams).QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
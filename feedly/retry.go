@@ -0,0 +1,198 @@
+package feedly
+
+import (
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how a Client retries requests that failed with a transient error.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the initial one, made for a request.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry. Subsequent retries double the delay.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts.
+	MaxBackoff time.Duration
+	// Jitter is the fraction, between 0 and 1, of each delay that is randomized.
+	Jitter float64
+	// StatusCodes are the HTTP status codes of responses that are retried.
+	StatusCodes []int
+	// Methods are the HTTP methods of requests that are retried. Requests using any other method, such as the POST
+	// requests sent by MarkerService.Mark, are never replayed.
+	Methods []string
+	// RespectRetryAfter makes the Retry-After header of a response take precedence over the computed backoff.
+	RespectRetryAfter bool
+	// MaxRetryAfter is the longest Retry-After delay that is waited for. A response asking for a longer delay is
+	// returned to the caller instead. A zero value does not limit the delay.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryPolicy returns a RetryPolicy that retries idempotent requests up to 3 times when Feedly answers with
+// 429 Too Many Requests or a transient 5xx status code.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		Methods: []string{
+			http.MethodDelete,
+			http.MethodGet,
+			http.MethodHead,
+			http.MethodOptions,
+			http.MethodPut,
+		},
+		RespectRetryAfter: true,
+		MaxRetryAfter:     time.Minute,
+	}
+}
+
+// WithRetryPolicy returns a function that initializes a Client with a retry policy.
+func WithRetryPolicy(retryPolicy *RetryPolicy) func(*Client) {
+	return func(c *Client) {
+		c.retryPolicy = retryPolicy
+	}
+}
+
+// isRetryableMethod reports whether requests using method may be replayed.
+func (p *RetryPolicy) isRetryableMethod(method string) bool {
+	for _, m := range p.Methods {
+		if m == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRetryableStatusCode reports whether responses with statusCode are retried.
+func (p *RetryPolicy) isRetryableStatusCode(statusCode int) bool {
+	for _, c := range p.StatusCodes {
+		if c == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay to wait before attempt+1 and whether the request should be retried at all.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	if p.RespectRetryAfter && resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if p.MaxRetryAfter > 0 && retryAfter > p.MaxRetryAfter {
+				return 0, false
+			}
+
+			return retryAfter, true
+		}
+	}
+
+	delay := float64(p.MinBackoff) * math.Pow(2, float64(attempt-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		delay -= delay * p.Jitter * rand.Float64()
+	}
+
+	return time.Duration(delay), true
+}
+
+// parseRetryAfter parses the value of a Retry-After header, expressed either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			seconds = 0
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// retryTransport is an http.RoundTripper that retries requests according to a RetryPolicy.
+type retryTransport struct {
+	base   http.RoundTripper
+	policy *RetryPolicy
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Requests that are not idempotent or whose body cannot be rewound are sent exactly once.
+	if !t.policy.isRetryableMethod(req.Method) || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if attempt >= t.policy.MaxAttempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		if err == nil && !t.policy.isRetryableStatusCode(resp.StatusCode) {
+			return resp, nil
+		}
+
+		delay, ok := t.policy.backoff(attempt, resp)
+		if !ok {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
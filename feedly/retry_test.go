@@ -0,0 +1,155 @@
+package feedly_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// retryServer answers the first failures requests with status and the Retry-After header retryAfter, then succeeds.
+type retryServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests int
+}
+
+func newRetryServer(t *testing.T, failures int, status int, retryAfter string) *retryServer {
+	s := &retryServer{}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests++
+		requests := s.requests
+		s.mu.Unlock()
+
+		if requests <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+
+			w.WriteHeader(status)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "user"}`))
+	}))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *retryServer) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// testRetryPolicy returns a RetryPolicy without jitter, so its delays are predictable.
+func testRetryPolicy(minBackoff time.Duration) *feedly.RetryPolicy {
+	policy := feedly.DefaultRetryPolicy()
+	policy.MinBackoff = minBackoff
+	policy.Jitter = 0
+
+	return policy
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Run("Backoff", func(t *testing.T) {
+		server := newRetryServer(t, 2, http.StatusServiceUnavailable, "")
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL), feedly.WithRetryPolicy(testRetryPolicy(20*time.Millisecond)))
+
+		start := time.Now()
+
+		profileListResponse, _, err := client.Profile.List()
+		require.NoError(t, err)
+		assert.Equal(t, "user", *profileListResponse.Profile.ID)
+		assert.Equal(t, 3, server.count())
+		// Waits 20ms, then 40ms.
+		assert.GreaterOrEqual(t, int64(time.Since(start)), int64(60*time.Millisecond))
+	})
+
+	t.Run("MaxAttempts", func(t *testing.T) {
+		server := newRetryServer(t, 10, http.StatusBadGateway, "")
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL), feedly.WithRetryPolicy(testRetryPolicy(time.Millisecond)))
+
+		_, resp, err := client.Profile.List()
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+		assert.Equal(t, feedly.DefaultRetryPolicy().MaxAttempts, server.count())
+	})
+
+	t.Run("POSTNotRetried", func(t *testing.T) {
+		server := newRetryServer(t, 1, http.StatusServiceUnavailable, "")
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL), feedly.WithRetryPolicy(testRetryPolicy(time.Millisecond)))
+
+		_, err := client.Markers.Mark(feedly.MarkAsRead, feedly.Entries, &feedly.MarkerMarkOptionalParams{
+			EntryIDs: []string{"entry"},
+		})
+		require.Error(t, err)
+		assert.Equal(t, 1, server.count())
+	})
+
+	t.Run("StatusNotRetried", func(t *testing.T) {
+		server := newRetryServer(t, 1, http.StatusNotFound, "")
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL), feedly.WithRetryPolicy(testRetryPolicy(time.Millisecond)))
+
+		_, _, err := client.Profile.List()
+		assert.True(t, errors.Is(err, feedly.ErrNotFound))
+		assert.Equal(t, 1, server.count())
+	})
+
+	t.Run("RetryAfter", func(t *testing.T) {
+		server := newRetryServer(t, 1, http.StatusTooManyRequests, "1")
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL), feedly.WithRetryPolicy(testRetryPolicy(time.Millisecond)))
+
+		start := time.Now()
+
+		_, _, err := client.Profile.List()
+		require.NoError(t, err)
+		assert.Equal(t, 2, server.count())
+		assert.GreaterOrEqual(t, int64(time.Since(start)), int64(time.Second))
+	})
+
+	t.Run("MaxRetryAfter", func(t *testing.T) {
+		server := newRetryServer(t, 1, http.StatusTooManyRequests, "120")
+
+		policy := testRetryPolicy(time.Millisecond)
+		policy.MaxRetryAfter = time.Second
+
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL), feedly.WithRetryPolicy(policy))
+
+		start := time.Now()
+
+		_, _, err := client.Profile.List()
+		assert.True(t, errors.Is(err, feedly.ErrRateLimited))
+		assert.Equal(t, 1, server.count())
+		assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	})
+
+	t.Run("ContextCancelledWhileWaiting", func(t *testing.T) {
+		server := newRetryServer(t, 1, http.StatusServiceUnavailable, "")
+		client := feedly.NewClient(server.Client(), feedly.WithAPIBaseURL(server.URL), feedly.WithRetryPolicy(testRetryPolicy(time.Minute)))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+
+		_, _, err := client.Profile.ListWithContext(ctx)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded), "%v", err)
+		assert.Equal(t, 1, server.count())
+		assert.Less(t, int64(time.Since(start)), int64(time.Minute))
+	})
+}
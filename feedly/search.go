@@ -1,6 +1,7 @@
 package feedly
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strings"
@@ -90,6 +91,11 @@ type SearchFeedsResponse struct {
 
 // Feeds returns matching feeds.
 func (s *SearchService) Feeds(query string, optionalParams *SearchFeedsOptionalParams) (*SearchFeedsResponse, *http.Response, error) {
+	return s.FeedsWithContext(context.Background(), query, optionalParams)
+}
+
+// FeedsWithContext is like Feeds but uses ctx to control the lifetime of the request.
+func (s *SearchService) FeedsWithContext(ctx context.Context, query string, optionalParams *SearchFeedsOptionalParams) (*SearchFeedsResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &SearchFeedsOptionalParams{}
 	}
@@ -104,8 +110,8 @@ func (s *SearchService) Feeds(query string, optionalParams *SearchFeedsOptionalP
 	decodedResponse := new(SearchFeedsResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("search/feeds").QueryStruct(requiredParams).QueryStruct(optionalParams).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("search/feeds").QueryStruct(requiredParams).QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -118,7 +124,7 @@ func (s *SearchService) Feeds(query string, optionalParams *SearchFeedsOptionalP
 
 // SearchStreamOptionalParams are the optional parameters for SearchService.Stream.
 type SearchStreamOptionalParams struct {
-	Continuation *string           `url:"count,omitempty"`
+	Continuation *string           `url:"continuation,omitempty"`
 	Count        *int              `url:"count,omitempty"`
 	Embedded     *EmbeddedFilter   `url:"embedded,omitempty"`
 	Engagement   *EngagementFilter `url:"engagement,omitempty"`
@@ -139,7 +145,12 @@ type SearchStreamResponse struct {
 }
 
 // Stream returns matching content in a stream.
-func (s *SearchService) Stream(streamID string, query string, optionalParams *SearchStreamOptionalParams) (*SearchStreamResponse, *http.Response, error) {
+func (s *SearchService) Stream(streamID StreamID, query string, optionalParams *SearchStreamOptionalParams) (*SearchStreamResponse, *http.Response, error) {
+	return s.StreamWithContext(context.Background(), streamID, query, optionalParams)
+}
+
+// StreamWithContext is like Stream but uses ctx to control the lifetime of the request.
+func (s *SearchService) StreamWithContext(ctx context.Context, streamID StreamID, query string, optionalParams *SearchStreamOptionalParams) (*SearchStreamResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &SearchStreamOptionalParams{}
 	}
@@ -149,15 +160,15 @@ func (s *SearchService) Stream(streamID string, query string, optionalParams *Se
 		StreamID string `url:"streamId,omitempty"`
 	}{
 		Query:    query,
-		StreamID: streamID,
+		StreamID: streamID.String(),
 	}
 
 	encodedResponse := make(map[string]interface{})
 	decodedResponse := new(SearchStreamResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("search/contents").QueryStruct(requiredParams).QueryStruct(optionalParams).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("search/contents").QueryStruct(requiredParams).QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -167,3 +178,34 @@ func (s *SearchService) Stream(streamID string, query string, optionalParams *Se
 
 	return decodedResponse, resp, nil
 }
+
+// Iterate returns an EntryIterator over the matching content in a stream, following continuations until the results
+// are exhausted.
+func (s *SearchService) Iterate(streamID StreamID, query string, optionalParams *SearchStreamOptionalParams, iteratorParams *EntryIteratorOptionalParams) *EntryIterator {
+	return s.IterateWithContext(context.Background(), streamID, query, optionalParams, iteratorParams)
+}
+
+// IterateWithContext is like Iterate but uses ctx to control the lifetime of the requests.
+func (s *SearchService) IterateWithContext(ctx context.Context, streamID StreamID, query string, optionalParams *SearchStreamOptionalParams, iteratorParams *EntryIteratorOptionalParams) *EntryIterator {
+	params := SearchStreamOptionalParams{}
+	if optionalParams != nil {
+		params = *optionalParams
+	}
+
+	newerThan := params.NewerThan
+
+	return newEntryIterator(ctx, func(ctx context.Context, continuation *string, iteratorNewerThan *time.Time) (*Stream, error) {
+		if continuation != nil {
+			params.Continuation = continuation
+		}
+
+		params.NewerThan = laterTime(newerThan, iteratorNewerThan)
+
+		streamResponse, _, err := s.StreamWithContext(ctx, streamID, query, &params)
+		if err != nil {
+			return nil, err
+		}
+
+		return &streamResponse.Stream, nil
+	}, iteratorParams, false)
+}
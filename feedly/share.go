@@ -0,0 +1,54 @@
+package feedly
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+	"github.com/sfanous/go-feedly/internal/mapstructure"
+	"github.com/sfanous/go-feedly/pkg/time"
+)
+
+// ShareService provides methods for sharing entries.
+type ShareService struct {
+	sling *sling.Sling
+}
+
+// newShareService returns a new ShareService.
+func newShareService(sling *sling.Sling) *ShareService {
+	return &ShareService{
+		sling: sling,
+	}
+}
+
+// ShareShortenEntryResponse represents the response from ShareService.ShortenEntry.
+type ShareShortenEntryResponse struct {
+	ExpiresOn      *time.Time             `json:"expiresOn,omitempty"`
+	ShortURL       *string                `json:"shortUrl,omitempty"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// ShortenEntry returns a Feedly short link to an entry.
+// https://developer.feedly.com/v3/shorten/
+func (s *ShareService) ShortenEntry(entryID string) (*ShareShortenEntryResponse, *http.Response, error) {
+	return s.ShortenEntryWithContext(context.Background(), entryID)
+}
+
+// ShortenEntryWithContext is like ShortenEntry but uses ctx to control the lifetime of the request.
+func (s *ShareService) ShortenEntryWithContext(ctx context.Context, entryID string) (*ShareShortenEntryResponse, *http.Response, error) {
+	encodedResponse := make(map[string]interface{})
+	decodedResponse := new(ShareShortenEntryResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("shorten/entries/"+url.PathEscape(entryID)), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, decodedResponse); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
@@ -0,0 +1,39 @@
+package feedly_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShare(t *testing.T) {
+	server, entryIDs := newTestServer(t, 1)
+	client := server.Client()
+
+	shortenResponse, _, err := client.Share.ShortenEntry(entryIDs[0])
+	require.NoError(t, err)
+	require.NotNil(t, shortenResponse.ShortURL)
+	assert.True(t, strings.HasPrefix(*shortenResponse.ShortURL, server.URL))
+	assert.True(t, shortenResponse.ExpiresOn.After(time.Now()))
+
+	_, _, err = client.Share.ShortenEntry("unknown")
+	assert.True(t, errors.Is(err, feedly.ErrNotFound))
+
+	for body, expected := range map[string]string{
+		`{"canonicalUrl": "https://example.com/a", "canonical": [{"href": "https://example.com/b"}]}`:          "https://example.com/a",
+		`{"canonical": [{"href": "https://example.com/b"}], "alternate": [{"href": "https://example.com/c"}]}`: "https://example.com/b",
+		`{"alternate": [{"href": "https://example.com/c"}], "originId": "https://example.com/d"}`:              "https://example.com/c",
+		`{"originId": "https://example.com/d"}`:                                                                "https://example.com/d",
+		`{"originId": "tag:example.com,2020:1"}`:                                                               "",
+	} {
+		entry := feedly.Entry{}
+		require.NoError(t, json.Unmarshal([]byte(body), &entry))
+		assert.Equal(t, expected, entry.BestURL(), body)
+	}
+}
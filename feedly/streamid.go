@@ -0,0 +1,191 @@
+package feedly
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StreamType classifies a StreamID.
+type StreamType string
+
+const (
+	AlertStreamType              StreamType = "alert"
+	CategoryStreamType           StreamType = "category"
+	EnterpriseCategoryStreamType StreamType = "enterpriseCategory"
+	EnterpriseTagStreamType      StreamType = "enterpriseTag"
+	FeedStreamType               StreamType = "feed"
+	TagStreamType                StreamType = "tag"
+	TopicStreamType              StreamType = "topic"
+	UnknownStreamType            StreamType = ""
+)
+
+const (
+	globalAllLabel      = "global.all"
+	globalMustReadLabel = "global.must"
+	globalReadLabel     = "global.read"
+	globalSavedLabel    = "global.saved"
+)
+
+// StreamID is the ID of a Feedly stream, such as "feed/http://feeds.engadget.com/weblogsinc/engadget" or
+// "user/<userID>/category/global.all". The services take a StreamID wherever a stream, collection, board, or feed ID
+// is expected; convert a raw ID with StreamID(id) or validate it with ParseStreamID.
+// https://developer.feedly.com/cloud/#streams
+type StreamID string
+
+// FeedStream returns the StreamID of the feed at feedURL.
+func FeedStream(feedURL string) StreamID {
+	return StreamID("feed/" + feedURL)
+}
+
+// CategoryStream returns the StreamID of the category, aka collection, label of the user userID.
+func CategoryStream(userID string, label string) StreamID {
+	return StreamID("user/" + userID + "/category/" + label)
+}
+
+// TagStream returns the StreamID of the tag, aka board, label of the user userID.
+func TagStream(userID string, label string) StreamID {
+	return StreamID("user/" + userID + "/tag/" + label)
+}
+
+// AlertStream returns the StreamID of the alert alertID of the user userID.
+func AlertStream(userID string, alertID string) StreamID {
+	return StreamID("user/" + userID + "/alert/" + alertID)
+}
+
+// GlobalAll returns the StreamID of the category containing all the feeds of the user userID.
+func GlobalAll(userID string) StreamID {
+	return CategoryStream(userID, globalAllLabel)
+}
+
+// GlobalMustRead returns the StreamID of the category containing the must read feeds of the user userID.
+func GlobalMustRead(userID string) StreamID {
+	return CategoryStream(userID, globalMustReadLabel)
+}
+
+// GlobalRead returns the StreamID of the tag containing the entries read by the user userID.
+func GlobalRead(userID string) StreamID {
+	return TagStream(userID, globalReadLabel)
+}
+
+// GlobalSaved returns the StreamID of the tag containing the entries saved for later by the user userID.
+func GlobalSaved(userID string) StreamID {
+	return TagStream(userID, globalSavedLabel)
+}
+
+// EnterpriseCategory returns the StreamID of the category categoryID of the enterprise enterpriseName.
+func EnterpriseCategory(enterpriseName string, categoryID string) StreamID {
+	return StreamID("enterprise/" + enterpriseName + "/category/" + categoryID)
+}
+
+// EnterpriseTag returns the StreamID of the tag tagID of the enterprise enterpriseName.
+func EnterpriseTag(enterpriseName string, tagID string) StreamID {
+	return StreamID("enterprise/" + enterpriseName + "/tag/" + tagID)
+}
+
+// TopicStream returns the StreamID of the topic topic.
+func TopicStream(topic string) StreamID {
+	return StreamID("topic/" + topic)
+}
+
+// ParseStreamID parses s into a StreamID, returning an error if s is not a recognized stream ID.
+func ParseStreamID(s string) (StreamID, error) {
+	id := StreamID(s)
+
+	if id.Type() == UnknownStreamType {
+		return "", fmt.Errorf("feedly: unrecognized stream ID %q", s)
+	}
+
+	return id, nil
+}
+
+// String returns the string representation of a StreamID.
+func (id StreamID) String() string {
+	return string(id)
+}
+
+// Type returns the type of the stream identified by id.
+func (id StreamID) Type() StreamType {
+	s := string(id)
+
+	switch {
+	case strings.HasPrefix(s, "feed/") && len(s) > len("feed/"):
+		return FeedStreamType
+	case strings.HasPrefix(s, "topic/") && len(s) > len("topic/"):
+		return TopicStreamType
+	}
+
+	parts := strings.SplitN(s, "/", 4)
+	if len(parts) != 4 || parts[1] == "" || parts[3] == "" {
+		return UnknownStreamType
+	}
+
+	switch parts[0] + "/" + parts[2] {
+	case "user/alert":
+		return AlertStreamType
+	case "user/category":
+		return CategoryStreamType
+	case "user/tag":
+		return TagStreamType
+	case "enterprise/category":
+		return EnterpriseCategoryStreamType
+	case "enterprise/tag":
+		return EnterpriseTagStreamType
+	}
+
+	return UnknownStreamType
+}
+
+// FeedURL returns the URL of the feed identified by id, or an empty string if id is not a feed stream.
+func (id StreamID) FeedURL() string {
+	if id.Type() != FeedStreamType {
+		return ""
+	}
+
+	return strings.TrimPrefix(string(id), "feed/")
+}
+
+// UserID returns the ID of the user owning the alert, category, or tag identified by id, or an empty string if id is
+// not a user alert, category, or tag stream.
+func (id StreamID) UserID() string {
+	switch id.Type() {
+	case AlertStreamType, CategoryStreamType, TagStreamType:
+		return strings.SplitN(string(id), "/", 4)[1]
+	}
+
+	return ""
+}
+
+// EnterpriseName returns the name of the enterprise owning the category or tag identified by id, or an empty string
+// if id is not an enterprise stream.
+func (id StreamID) EnterpriseName() string {
+	switch id.Type() {
+	case EnterpriseCategoryStreamType, EnterpriseTagStreamType:
+		return strings.SplitN(string(id), "/", 4)[1]
+	}
+
+	return ""
+}
+
+// Label returns the label of the category or tag, the ID of the alert, or the name of the topic identified by id. It
+// returns an empty string for feed streams.
+func (id StreamID) Label() string {
+	switch id.Type() {
+	case AlertStreamType, CategoryStreamType, TagStreamType, EnterpriseCategoryStreamType, EnterpriseTagStreamType:
+		return strings.SplitN(string(id), "/", 4)[3]
+	case TopicStreamType:
+		return strings.TrimPrefix(string(id), "topic/")
+	}
+
+	return ""
+}
+
+// IsGlobal reports whether id identifies one of the global categories or tags Feedly maintains for every user, such
+// as global.all or global.saved.
+func (id StreamID) IsGlobal() bool {
+	switch id.Type() {
+	case CategoryStreamType, TagStreamType:
+		return strings.HasPrefix(id.Label(), "global.")
+	}
+
+	return false
+}
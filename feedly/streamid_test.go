@@ -0,0 +1,129 @@
+package feedly_test
+
+import (
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStreamID(t *testing.T) {
+	for name, test := range map[string]struct {
+		s     string
+		valid bool
+	}{
+		"Alert":              {"user/user-1/alert/alert-1", true},
+		"Category":           {"user/user-1/category/tech", true},
+		"EnterpriseCategory": {"enterprise/acme/category/research", true},
+		"EnterpriseTag":      {"enterprise/acme/tag/shared", true},
+		"Feed":               {"feed/http://feeds.engadget.com/weblogsinc/engadget", true},
+		"Tag":                {"user/user-1/tag/global.saved", true},
+		"Topic":              {"topic/security", true},
+		"Empty":              {"", false},
+		"EmptyFeedURL":       {"feed/", false},
+		"EmptyLabel":         {"user/user-1/category/", false},
+		"EmptyTopic":         {"topic/", false},
+		"EmptyUserID":        {"user//category/tech", false},
+		"MissingLabel":       {"user/user-1/category", false},
+		"UnknownKind":        {"user/user-1/board/tech", false},
+		"UnknownOwner":       {"team/acme/category/tech", false},
+	} {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			streamID, err := feedly.ParseStreamID(test.s)
+			if !test.valid {
+				assert.Error(t, err)
+				assert.Equal(t, feedly.StreamID(""), streamID)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.s, streamID.String())
+		})
+	}
+}
+
+func TestStreamIDType(t *testing.T) {
+	for name, test := range map[string]struct {
+		streamID feedly.StreamID
+		expected feedly.StreamType
+	}{
+		"Alert":              {feedly.AlertStream("user-1", "alert-1"), feedly.AlertStreamType},
+		"Category":           {feedly.CategoryStream("user-1", "tech"), feedly.CategoryStreamType},
+		"EnterpriseCategory": {feedly.EnterpriseCategory("acme", "research"), feedly.EnterpriseCategoryStreamType},
+		"EnterpriseTag":      {feedly.EnterpriseTag("acme", "shared"), feedly.EnterpriseTagStreamType},
+		"Feed":               {feedly.FeedStream("http://feeds.engadget.com/weblogsinc/engadget"), feedly.FeedStreamType},
+		"GlobalAll":          {feedly.GlobalAll("user-1"), feedly.CategoryStreamType},
+		"GlobalSaved":        {feedly.GlobalSaved("user-1"), feedly.TagStreamType},
+		"Tag":                {feedly.TagStream("user-1", "later"), feedly.TagStreamType},
+		"Topic":              {feedly.TopicStream("security"), feedly.TopicStreamType},
+		"Unknown":            {feedly.StreamID("user/user-1/board/tech"), feedly.UnknownStreamType},
+	} {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.streamID.Type())
+		})
+	}
+}
+
+func TestStreamIDLabel(t *testing.T) {
+	for name, test := range map[string]struct {
+		streamID feedly.StreamID
+		expected string
+	}{
+		"Alert":              {feedly.AlertStream("user-1", "alert-1"), "alert-1"},
+		"Category":           {feedly.CategoryStream("user-1", "tech"), "tech"},
+		"CategoryWithSlash":  {feedly.CategoryStream("user-1", "tech/go"), "tech/go"},
+		"EnterpriseCategory": {feedly.EnterpriseCategory("acme", "research"), "research"},
+		"EnterpriseTag":      {feedly.EnterpriseTag("acme", "shared"), "shared"},
+		"Feed":               {feedly.FeedStream("http://feeds.engadget.com/weblogsinc/engadget"), ""},
+		"Tag":                {feedly.TagStream("user-1", "later"), "later"},
+		"Topic":              {feedly.TopicStream("security"), "security"},
+		"Unknown":            {feedly.StreamID("user/user-1/board/tech"), ""},
+	} {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.streamID.Label())
+		})
+	}
+}
+
+func TestStreamIDIsGlobal(t *testing.T) {
+	for name, test := range map[string]struct {
+		streamID feedly.StreamID
+		expected bool
+	}{
+		"Alert":                  {feedly.AlertStream("user-1", "global.all"), false},
+		"Category":               {feedly.CategoryStream("user-1", "tech"), false},
+		"EnterpriseCategory":     {feedly.EnterpriseCategory("acme", "global.all"), false},
+		"Feed":                   {feedly.FeedStream("http://feeds.engadget.com/weblogsinc/engadget"), false},
+		"GlobalAll":              {feedly.GlobalAll("user-1"), true},
+		"GlobalMustRead":         {feedly.GlobalMustRead("user-1"), true},
+		"GlobalRead":             {feedly.GlobalRead("user-1"), true},
+		"GlobalSaved":            {feedly.GlobalSaved("user-1"), true},
+		"Tag":                    {feedly.TagStream("user-1", "later"), false},
+		"TopicNamedLikeGlobal":   {feedly.TopicStream("global.all"), false},
+		"UnknownNamedLikeGlobal": {feedly.StreamID("user/user-1/board/global.all"), false},
+	} {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.streamID.IsGlobal())
+		})
+	}
+}
+
+func TestStreamIDOwners(t *testing.T) {
+	assert.Equal(t, "user-1", feedly.AlertStream("user-1", "alert-1").UserID())
+	assert.Equal(t, "user-1", feedly.CategoryStream("user-1", "tech").UserID())
+	assert.Equal(t, "", feedly.EnterpriseTag("acme", "shared").UserID())
+	assert.Equal(t, "acme", feedly.EnterpriseTag("acme", "shared").EnterpriseName())
+	assert.Equal(t, "", feedly.TagStream("user-1", "later").EnterpriseName())
+	assert.Equal(t, "http://example.com/rss", feedly.FeedStream("http://example.com/rss").FeedURL())
+	assert.Equal(t, "", feedly.TopicStream("security").FeedURL())
+}
@@ -1,6 +1,7 @@
 package feedly
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -66,7 +67,12 @@ type StreamContentResponse struct {
 }
 
 // Content returns the content of a stream.
-func (s *StreamService) Content(streamID string, optionalParams *StreamContentOptionalParams) (*StreamContentResponse, *http.Response, error) {
+func (s *StreamService) Content(streamID StreamID, optionalParams *StreamContentOptionalParams) (*StreamContentResponse, *http.Response, error) {
+	return s.ContentWithContext(context.Background(), streamID, optionalParams)
+}
+
+// ContentWithContext is like Content but uses ctx to control the lifetime of the request.
+func (s *StreamService) ContentWithContext(ctx context.Context, streamID StreamID, optionalParams *StreamContentOptionalParams) (*StreamContentResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &StreamContentOptionalParams{}
 	}
@@ -75,8 +81,8 @@ func (s *StreamService) Content(streamID string, optionalParams *StreamContentOp
 	decodedResponse := new(StreamContentResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("streams/"+url.PathEscape(streamID)+"/contents").QueryStruct(optionalParams).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("streams/"+url.PathEscape(streamID.String())+"/contents").QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -104,7 +110,12 @@ type StreamEntryIDsResponse struct {
 }
 
 // EntryIDs returns the IDs of entries in a stream.
-func (s *StreamService) EntryIDs(streamID string, optionalParams *StreamEntryIDsOptionalParams) (*StreamEntryIDsResponse, *http.Response, error) {
+func (s *StreamService) EntryIDs(streamID StreamID, optionalParams *StreamEntryIDsOptionalParams) (*StreamEntryIDsResponse, *http.Response, error) {
+	return s.EntryIDsWithContext(context.Background(), streamID, optionalParams)
+}
+
+// EntryIDsWithContext is like EntryIDs but uses ctx to control the lifetime of the request.
+func (s *StreamService) EntryIDsWithContext(ctx context.Context, streamID StreamID, optionalParams *StreamEntryIDsOptionalParams) (*StreamEntryIDsResponse, *http.Response, error) {
 	if optionalParams == nil {
 		optionalParams = &StreamEntryIDsOptionalParams{}
 	}
@@ -113,8 +124,8 @@ func (s *StreamService) EntryIDs(streamID string, optionalParams *StreamEntryIDs
 	decodedResponse := new(StreamEntryIDsResponse)
 	apiError := new(APIError)
 
-	resp, err := s.sling.New().Get("streams/"+url.PathEscape(streamID)+"/ids").QueryStruct(optionalParams).Receive(&encodedResponse, apiError)
-	if err := relevantError(err, apiError); err != nil {
+	resp, err := receive(ctx, s.sling.New().Get("streams/"+url.PathEscape(streamID.String())+"/ids").QueryStruct(optionalParams), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
 		return nil, resp, err
 	}
 
@@ -124,3 +135,34 @@ func (s *StreamService) EntryIDs(streamID string, optionalParams *StreamEntryIDs
 
 	return decodedResponse, resp, nil
 }
+
+// Iterate returns an EntryIterator over the content of a stream, following continuations until the stream is
+// exhausted.
+func (s *StreamService) Iterate(streamID StreamID, optionalParams *StreamContentOptionalParams, iteratorParams *EntryIteratorOptionalParams) *EntryIterator {
+	return s.IterateWithContext(context.Background(), streamID, optionalParams, iteratorParams)
+}
+
+// IterateWithContext is like Iterate but uses ctx to control the lifetime of the requests.
+func (s *StreamService) IterateWithContext(ctx context.Context, streamID StreamID, optionalParams *StreamContentOptionalParams, iteratorParams *EntryIteratorOptionalParams) *EntryIterator {
+	params := StreamContentOptionalParams{}
+	if optionalParams != nil {
+		params = *optionalParams
+	}
+
+	newerThan := params.NewerThan
+
+	return newEntryIterator(ctx, func(ctx context.Context, continuation *string, iteratorNewerThan *time.Time) (*Stream, error) {
+		if continuation != nil {
+			params.Continuation = continuation
+		}
+
+		params.NewerThan = laterTime(newerThan, iteratorNewerThan)
+
+		contentResponse, _, err := s.ContentWithContext(ctx, streamID, &params)
+		if err != nil {
+			return nil, err
+		}
+
+		return contentResponse.Stream, nil
+	}, iteratorParams, params.Ranked == nil || *params.Ranked == Newest)
+}
@@ -28,7 +28,7 @@ func testStreamServiceContent(t *testing.T, stream interface{}) {
 		streamType = "system"
 	}
 
-	contentResponse, resp, err := client.Streams.Content(streamID, &feedly.StreamContentOptionalParams{
+	contentResponse, resp, err := client.Streams.Content(feedly.StreamID(streamID), &feedly.StreamContentOptionalParams{
 		Count:      feedly.NewInt(100),
 		Ranked:     feedly.NewContentRank(feedly.Newest),
 		UnreadOnly: feedly.NewBool(true),
@@ -72,7 +72,7 @@ func testStreamServiceEntryIDs(t *testing.T, stream interface{}) {
 		streamID = stream
 	}
 
-	entryIDsResponse, resp, err := client.Streams.EntryIDs(streamID, &feedly.StreamEntryIDsOptionalParams{
+	entryIDsResponse, resp, err := client.Streams.EntryIDs(feedly.StreamID(streamID), &feedly.StreamEntryIDsOptionalParams{
 		Count:      feedly.NewInt(100),
 		Ranked:     feedly.NewContentRank(feedly.Newest),
 		UnreadOnly: feedly.NewBool(true),
@@ -0,0 +1,268 @@
+package feedly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+	"github.com/sfanous/go-feedly/internal/mapstructure"
+)
+
+// SubscriptionService provides methods for managing feed subscriptions.
+type SubscriptionService struct {
+	sling *sling.Sling
+}
+
+// newSubscriptionService returns a new SubscriptionService.
+func newSubscriptionService(sling *sling.Sling) *SubscriptionService {
+	return &SubscriptionService{
+		sling: sling,
+	}
+}
+
+// SubscriptionListResponse represents the response from SubscriptionService.List.
+type SubscriptionListResponse struct {
+	Feeds          []Feed                 `json:"feeds"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// List returns the list of subscriptions along with the collections each feed is part of.
+func (s *SubscriptionService) List() (*SubscriptionListResponse, *http.Response, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but uses ctx to control the lifetime of the request.
+func (s *SubscriptionService) ListWithContext(ctx context.Context) (*SubscriptionListResponse, *http.Response, error) {
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(SubscriptionListResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("subscriptions"), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Feeds); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// SubscriptionMultipleUpdateResponse represents the response from SubscriptionService.MultipleUpdate.
+type SubscriptionMultipleUpdateResponse struct {
+	Feeds          []Feed                 `json:"feeds"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// MultipleUpdate updates one or more subscriptions. The ID, Title, and Categories of each feed are sent; a feed the
+// user is not yet subscribed to is subscribed to.
+func (s *SubscriptionService) MultipleUpdate(feeds []Feed) (*SubscriptionMultipleUpdateResponse, *http.Response, error) {
+	return s.MultipleUpdateWithContext(context.Background(), feeds)
+}
+
+// MultipleUpdateWithContext is like MultipleUpdate but uses ctx to control the lifetime of the request.
+func (s *SubscriptionService) MultipleUpdateWithContext(ctx context.Context, feeds []Feed) (*SubscriptionMultipleUpdateResponse, *http.Response, error) {
+	bodyJSON := make([]*subscriptionBody, 0, len(feeds))
+
+	for i := range feeds {
+		bodyJSON = append(bodyJSON, newSubscriptionBody(&feeds[i]))
+	}
+
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(SubscriptionMultipleUpdateResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("subscriptions/.mput").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Feeds); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// SubscriptionSubscribeOptionalParams are the optional parameters for SubscriptionService.Subscribe.
+type SubscriptionSubscribeOptionalParams struct {
+	Collections []Collection `json:"categories,omitempty"`
+	Title       *string      `json:"title,omitempty"`
+}
+
+// SubscriptionSubscribeResponse represents the response from SubscriptionService.Subscribe.
+type SubscriptionSubscribeResponse struct {
+	Feeds          []Feed                 `json:"feeds"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Subscribe subscribes to a feed, adding it to the given collections.
+func (s *SubscriptionService) Subscribe(feedID StreamID, optionalParams *SubscriptionSubscribeOptionalParams) (*SubscriptionSubscribeResponse, *http.Response, error) {
+	return s.SubscribeWithContext(context.Background(), feedID, optionalParams)
+}
+
+// SubscribeWithContext is like Subscribe but uses ctx to control the lifetime of the request.
+func (s *SubscriptionService) SubscribeWithContext(ctx context.Context, feedID StreamID, optionalParams *SubscriptionSubscribeOptionalParams) (*SubscriptionSubscribeResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &SubscriptionSubscribeOptionalParams{}
+	}
+
+	bodyJSON := &subscriptionBody{
+		Collections: subscriptionCollections(optionalParams.Collections),
+		ID:          feedID.String(),
+		Title:       optionalParams.Title,
+	}
+
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(SubscriptionSubscribeResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("subscriptions").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Feeds); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// Unsubscribe unsubscribes from a feed, removing it from all collections.
+func (s *SubscriptionService) Unsubscribe(feedID StreamID) (*http.Response, error) {
+	return s.UnsubscribeWithContext(context.Background(), feedID)
+}
+
+// UnsubscribeWithContext is like Unsubscribe but uses ctx to control the lifetime of the request.
+func (s *SubscriptionService) UnsubscribeWithContext(ctx context.Context, feedID StreamID) (*http.Response, error) {
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Delete("subscriptions/"+url.PathEscape(feedID.String())), nil, apiError)
+
+	return resp, relevantError(resp, err, apiError)
+}
+
+// SubscriptionUpdateOptionalParams are the optional parameters for SubscriptionService.Update.
+type SubscriptionUpdateOptionalParams struct {
+	Collections []Collection `json:"categories,omitempty"`
+	Title       *string      `json:"title,omitempty"`
+}
+
+// SubscriptionUpdateResponse represents the response from SubscriptionService.Update.
+type SubscriptionUpdateResponse struct {
+	Feeds          []Feed                 `json:"feeds"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// Update updates the title or the collections of an existing subscription. The title and the collections left unset
+// keep their current value; the collections, when set, replace the current collections of the subscription, so an
+// empty non-nil slice removes the subscription from every collection. Unlike
+// Subscribe, Update lists the subscriptions first to read the current value of the unset fields, and returns an error
+// matching ErrNotFound if the user is not subscribed to the feed.
+func (s *SubscriptionService) Update(feedID StreamID, optionalParams *SubscriptionUpdateOptionalParams) (*SubscriptionUpdateResponse, *http.Response, error) {
+	return s.UpdateWithContext(context.Background(), feedID, optionalParams)
+}
+
+// UpdateWithContext is like Update but uses ctx to control the lifetime of the requests.
+func (s *SubscriptionService) UpdateWithContext(ctx context.Context, feedID StreamID, optionalParams *SubscriptionUpdateOptionalParams) (*SubscriptionUpdateResponse, *http.Response, error) {
+	if optionalParams == nil {
+		optionalParams = &SubscriptionUpdateOptionalParams{}
+	}
+
+	listResponse, resp, err := s.ListWithContext(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var subscription *Feed
+
+	for i := range listResponse.Feeds {
+		if listResponse.Feeds[i].ID != nil && *listResponse.Feeds[i].ID == feedID.String() {
+			subscription = &listResponse.Feeds[i]
+
+			break
+		}
+	}
+
+	if subscription == nil {
+		return nil, resp, fmt.Errorf("%w: not subscribed to %s", ErrNotFound, feedID)
+	}
+
+	// The collections are always sent, so an explicitly empty list clears them instead of being omitted.
+	bodyJSON := (*subscriptionUpdateBody)(newSubscriptionBody(subscription))
+
+	if optionalParams.Collections != nil {
+		bodyJSON.Collections = subscriptionCollections(optionalParams.Collections)
+	}
+
+	if optionalParams.Title != nil {
+		bodyJSON.Title = optionalParams.Title
+	}
+
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(SubscriptionUpdateResponse)
+	apiError := new(APIError)
+
+	resp, err = receive(ctx, s.sling.New().Post("subscriptions").BodyJSON(bodyJSON), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Feeds); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// subscriptionBody is the request body of the subscription endpoints.
+type subscriptionBody struct {
+	Collections []subscriptionCollection `json:"categories,omitempty"`
+	ID          string                   `json:"id"`
+	Title       *string                  `json:"title,omitempty"`
+}
+
+// subscriptionUpdateBody is like subscriptionBody but always sends the collections.
+type subscriptionUpdateBody struct {
+	Collections []subscriptionCollection `json:"categories"`
+	ID          string                   `json:"id"`
+	Title       *string                  `json:"title,omitempty"`
+}
+
+// subscriptionCollection is a collection as referenced by a subscription.
+type subscriptionCollection struct {
+	ID    *string `json:"id,omitempty"`
+	Label *string `json:"label,omitempty"`
+}
+
+// newSubscriptionBody returns the request body subscribing to feed.
+func newSubscriptionBody(feed *Feed) *subscriptionBody {
+	bodyJSON := &subscriptionBody{
+		Collections: subscriptionCollections(feed.Categories),
+		Title:       feed.Title,
+	}
+
+	if feed.ID != nil {
+		bodyJSON.ID = *feed.ID
+	}
+
+	return bodyJSON
+}
+
+// subscriptionCollections returns the references to collections.
+func subscriptionCollections(collections []Collection) []subscriptionCollection {
+	references := make([]subscriptionCollection, 0, len(collections))
+
+	for _, collection := range collections {
+		references = append(references, subscriptionCollection{
+			ID:    collection.ID,
+			Label: collection.Label,
+		})
+	}
+
+	return references
+}
@@ -0,0 +1,191 @@
+package feedly_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSubscriptionServiceList(t *testing.T) {
+	listResponse, resp, err := client.Subscriptions.List()
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+
+	assert.Nil(t, err)
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	if assert.NotNil(t, listResponse) {
+		assert.IsType(t, &feedly.SubscriptionListResponse{}, listResponse)
+
+		for _, collection := range responseCollections {
+			for _, feed := range collection.Feeds {
+				assert.True(t, isSubscribed(listResponse.Feeds, *feed.ID, *collection.ID), *feed.ID)
+			}
+		}
+
+		testUnmappedFields(t, listResponse, "SubscriptionListResponse")
+
+		if doLog {
+			b, err := json.MarshalIndent(listResponse, "", "    ")
+			if err != nil {
+				t.Logf("Failed to marshal listResponse: %v", err)
+			}
+
+			t.Log(string(b))
+		}
+	}
+}
+
+func testSubscriptionServiceUpdate(t *testing.T, collection *feedly.Collection) {
+	if len(collection.Feeds) == 0 {
+		t.Skipf("%s has no feeds", *collection.Label)
+	}
+
+	feed := collection.Feeds[0]
+
+	updateResponse, resp, err := client.Subscriptions.Update(feedly.StreamID(*feed.ID), &feedly.SubscriptionUpdateOptionalParams{
+		Title: feedly.NewString(*collection.Label + " subscription updated by go-feedly for testing"),
+	})
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+
+	assert.Nil(t, err)
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	if assert.NotNil(t, updateResponse) {
+		assert.IsType(t, &feedly.SubscriptionUpdateResponse{}, updateResponse)
+		assert.True(t, isSubscribed(updateResponse.Feeds, *feed.ID, *collection.ID), *feed.ID)
+
+		testUnmappedFields(t, updateResponse, "SubscriptionUpdateResponse")
+
+		if doLog {
+			b, err := json.MarshalIndent(updateResponse, "", "    ")
+			if err != nil {
+				t.Logf("Failed to marshal updateResponse: %v", err)
+			}
+
+			t.Log(string(b))
+		}
+	}
+}
+
+// isSubscribed reports whether feeds holds a subscription to the feed feedID as part of the collection collectionID.
+func isSubscribed(feeds []feedly.Feed, feedID string, collectionID string) bool {
+	for _, feed := range feeds {
+		if *feed.ID != feedID {
+			continue
+		}
+
+		for _, category := range feed.Categories {
+			if *category.ID == collectionID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// subscriptionsTransport records the bodies of the requests to the subscriptions endpoint.
+type subscriptionsTransport struct {
+	base   http.RoundTripper
+	bodies []string
+}
+
+func (t *subscriptionsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/subscriptions") {
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		t.bodies = append(t.bodies, string(b))
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+func TestSubscriptions(t *testing.T) {
+	server, _ := newTestServer(t, 0)
+	client := server.Client()
+
+	subscribeResponse, _, err := client.Subscriptions.Subscribe(testFeedID, &feedly.SubscriptionSubscribeOptionalParams{
+		Collections: []feedly.Collection{{Label: feedly.NewString("news")}, {Label: feedly.NewString("tech")}},
+		Title:       feedly.NewString("Example"),
+	})
+	require.NoError(t, err)
+	require.Len(t, subscribeResponse.Feeds, 1)
+	assert.Equal(t, "Example", *subscribeResponse.Feeds[0].Title)
+	assert.Len(t, subscribeResponse.Feeds[0].Categories, 2)
+
+	collectionID := *subscribeResponse.Feeds[0].Categories[0].ID
+
+	updateResponse, _, err := client.Subscriptions.Update(testFeedID, &feedly.SubscriptionUpdateOptionalParams{
+		Title: feedly.NewString("Renamed"),
+	})
+	require.NoError(t, err)
+	require.Len(t, updateResponse.Feeds, 1)
+	assert.Equal(t, "Renamed", *updateResponse.Feeds[0].Title)
+	assert.Len(t, updateResponse.Feeds[0].Categories, 2)
+
+	updateResponse, _, err = client.Subscriptions.Update(testFeedID, &feedly.SubscriptionUpdateOptionalParams{
+		Collections: []feedly.Collection{{ID: feedly.NewString(collectionID)}},
+	})
+	require.NoError(t, err)
+	require.Len(t, updateResponse.Feeds, 1)
+	assert.Equal(t, "Renamed", *updateResponse.Feeds[0].Title)
+	assert.Len(t, updateResponse.Feeds[0].Categories, 1)
+
+	_, _, err = client.Subscriptions.Update("feed/https://example.net/rss", &feedly.SubscriptionUpdateOptionalParams{
+		Title: feedly.NewString("Unknown"),
+	})
+	assert.True(t, errors.Is(err, feedly.ErrNotFound))
+
+	_, _, err = client.Subscriptions.MultipleUpdate([]feedly.Feed{{ID: feedly.NewString("feed/https://example.org/rss")}})
+	require.NoError(t, err)
+
+	listResponse, _, err := client.Subscriptions.List()
+	require.NoError(t, err)
+	require.Len(t, listResponse.Feeds, 2)
+	assert.Equal(t, testFeedID, *listResponse.Feeds[0].ID)
+	assert.Equal(t, "Renamed", *listResponse.Feeds[0].Title)
+	require.Len(t, listResponse.Feeds[0].Categories, 1)
+	assert.Equal(t, collectionID, *listResponse.Feeds[0].Categories[0].ID)
+	assert.Equal(t, "feed/https://example.org/rss", *listResponse.Feeds[1].ID)
+	assert.Empty(t, listResponse.Feeds[1].Categories)
+
+	transport := &subscriptionsTransport{base: server.Server.Client().Transport}
+	recordingClient := feedly.NewClient(&http.Client{Transport: transport}, feedly.WithAPIBaseURL(server.URL))
+
+	updateResponse, _, err = recordingClient.Subscriptions.Update(testFeedID, &feedly.SubscriptionUpdateOptionalParams{
+		Collections: []feedly.Collection{},
+	})
+	require.NoError(t, err)
+	require.Len(t, updateResponse.Feeds, 1)
+	assert.Empty(t, updateResponse.Feeds[0].Categories)
+	require.Len(t, transport.bodies, 1)
+	assert.JSONEq(t, `{"categories":[],"id":"`+testFeedID+`","title":"Renamed"}`, transport.bodies[0])
+
+	_, err = client.Subscriptions.Unsubscribe(testFeedID)
+	require.NoError(t, err)
+
+	detailsResponse, _, err := client.Collections.Details(feedly.StreamID(collectionID))
+	require.NoError(t, err)
+	assert.Empty(t, detailsResponse.Collections[0].Feeds)
+
+	_, err = client.Subscriptions.Unsubscribe(testFeedID)
+	assert.True(t, errors.Is(err, feedly.ErrNotFound))
+}
@@ -0,0 +1,180 @@
+package feedly
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dghubble/sling"
+	"github.com/sfanous/go-feedly/internal/mapstructure"
+)
+
+// TagService provides methods for managing tags, including the global.saved and global.read system tags. Tags are
+// represented by the Board type.
+type TagService struct {
+	sling *sling.Sling
+}
+
+// newTagService returns a new TagService.
+func newTagService(sling *sling.Sling) *TagService {
+	return &TagService{
+		sling: sling,
+	}
+}
+
+// Delete deletes one or more existing tags.
+func (s *TagService) Delete(tagIDs []StreamID) (*http.Response, error) {
+	return s.DeleteWithContext(context.Background(), tagIDs)
+}
+
+// DeleteWithContext is like Delete but uses ctx to control the lifetime of the request.
+func (s *TagService) DeleteWithContext(ctx context.Context, tagIDs []StreamID) (*http.Response, error) {
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Delete("tags/"+pathEscapeStreamIDs(tagIDs)), nil, apiError)
+
+	return resp, relevantError(resp, err, apiError)
+}
+
+// TagEntryTagsResponse represents the response from TagService.EntryTags.
+type TagEntryTagsResponse struct {
+	Tags           []Board                `json:"tags"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// EntryTags returns the tags an entry is tagged with.
+func (s *TagService) EntryTags(entryID string) (*TagEntryTagsResponse, *http.Response, error) {
+	return s.EntryTagsWithContext(context.Background(), entryID)
+}
+
+// EntryTagsWithContext is like EntryTags but uses ctx to control the lifetime of the request.
+func (s *TagService) EntryTagsWithContext(ctx context.Context, entryID string) (*TagEntryTagsResponse, *http.Response, error) {
+	encodedResponse := make([]map[string]interface{}, 0)
+	entries := make([]Entry, 0)
+	decodedResponse := new(TagEntryTagsResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("entries/"+url.PathEscape(entryID)), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &entries); err != nil {
+		return nil, resp, err
+	}
+
+	decodedResponse.Tags = make([]Board, 0)
+
+	for _, entry := range entries {
+		decodedResponse.Tags = append(decodedResponse.Tags, entry.Tags...)
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// TagListResponse represents the response from TagService.List.
+type TagListResponse struct {
+	Tags           []Board                `json:"tags"`
+	UnmappedFields map[string]interface{} `json:"-" mapstructure:",remain"`
+}
+
+// List returns the list of tags, including the system tags.
+func (s *TagService) List() (*TagListResponse, *http.Response, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but uses ctx to control the lifetime of the request.
+func (s *TagService) ListWithContext(ctx context.Context) (*TagListResponse, *http.Response, error) {
+	encodedResponse := make([]map[string]interface{}, 0)
+	decodedResponse := new(TagListResponse)
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Get("tags"), &encodedResponse, apiError)
+	if err := relevantError(resp, err, apiError); err != nil {
+		return nil, resp, err
+	}
+
+	if err := mapstructure.Decode(encodedResponse, &decodedResponse.Tags); err != nil {
+		return nil, resp, err
+	}
+
+	return decodedResponse, resp, nil
+}
+
+// Rename changes the label of an existing tag.
+func (s *TagService) Rename(tagID StreamID, label string) (*http.Response, error) {
+	return s.RenameWithContext(context.Background(), tagID, label)
+}
+
+// RenameWithContext is like Rename but uses ctx to control the lifetime of the request.
+func (s *TagService) RenameWithContext(ctx context.Context, tagID StreamID, label string) (*http.Response, error) {
+	bodyJSON := &struct {
+		Label string `json:"label"`
+	}{
+		Label: label,
+	}
+
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Post("tags/"+url.PathEscape(tagID.String())).BodyJSON(bodyJSON), nil, apiError)
+
+	return resp, relevantError(resp, err, apiError)
+}
+
+// TagEntries tags one or more entries with one or more existing tags.
+func (s *TagService) TagEntries(tagIDs []StreamID, entryIDs []string) (*http.Response, error) {
+	return s.TagEntriesWithContext(context.Background(), tagIDs, entryIDs)
+}
+
+// TagEntriesWithContext is like TagEntries but uses ctx to control the lifetime of the request.
+func (s *TagService) TagEntriesWithContext(ctx context.Context, tagIDs []StreamID, entryIDs []string) (*http.Response, error) {
+	bodyJSON := &struct {
+		EntryIDs []string `json:"entryIds"`
+	}{
+		EntryIDs: entryIDs,
+	}
+
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Put("tags/"+pathEscapeStreamIDs(tagIDs)).BodyJSON(bodyJSON), nil, apiError)
+
+	return resp, relevantError(resp, err, apiError)
+}
+
+// UntagEntries removes one or more tags from one or more entries.
+func (s *TagService) UntagEntries(tagIDs []StreamID, entryIDs []string) (*http.Response, error) {
+	return s.UntagEntriesWithContext(context.Background(), tagIDs, entryIDs)
+}
+
+// UntagEntriesWithContext is like UntagEntries but uses ctx to control the lifetime of the request.
+func (s *TagService) UntagEntriesWithContext(ctx context.Context, tagIDs []StreamID, entryIDs []string) (*http.Response, error) {
+	apiError := new(APIError)
+
+	resp, err := receive(ctx, s.sling.New().Delete("tags/"+pathEscapeStreamIDs(tagIDs)+"/"+pathEscapeIDs(entryIDs)), nil, apiError)
+
+	return resp, relevantError(resp, err, apiError)
+}
+
+// pathEscapeIDs returns ids as a single path segment. Each ID is escaped on its own, commas included, so the commas
+// separating the IDs remain distinguishable from the commas within them.
+func pathEscapeIDs(ids []string) string {
+	escaped := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		escaped = append(escaped, url.PathEscape(id))
+	}
+
+	return strings.Join(escaped, ",")
+}
+
+// pathEscapeStreamIDs is like pathEscapeIDs for stream IDs.
+func pathEscapeStreamIDs(ids []StreamID) string {
+	rawIDs := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		rawIDs = append(rawIDs, id.String())
+	}
+
+	return pathEscapeIDs(rawIDs)
+}
@@ -0,0 +1,100 @@
+package feedly_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/sfanous/go-feedly/feedly"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTagServiceList(t *testing.T) {
+	listResponse, resp, err := client.Tags.List()
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+
+	assert.Nil(t, err)
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	if assert.NotNil(t, listResponse) {
+		assert.IsType(t, &feedly.TagListResponse{}, listResponse)
+
+		tagIDs := make([]string, 0, len(listResponse.Tags))
+
+		for _, tag := range listResponse.Tags {
+			tagIDs = append(tagIDs, *tag.ID)
+		}
+
+		if profile != nil {
+			assert.Contains(t, tagIDs, feedly.GlobalSaved(*profile.ID).String())
+		}
+
+		for _, board := range responseBoards {
+			assert.Contains(t, tagIDs, *board.ID)
+		}
+
+		testUnmappedFields(t, listResponse, "TagListResponse")
+
+		if doLog {
+			b, err := json.MarshalIndent(listResponse, "", "    ")
+			if err != nil {
+				t.Logf("Failed to marshal listResponse: %v", err)
+			}
+
+			t.Log(string(b))
+		}
+	}
+}
+
+func TestTags(t *testing.T) {
+	server, entryIDs := newTestServer(t, 2)
+	client := server.Client()
+
+	tagID := feedly.TagStream(server.UserID, "research, notes/2020").String()
+	savedID := feedly.GlobalSaved(server.UserID).String()
+
+	_, _, err := client.Boards.Create("research", &feedly.BoardCreateOptionalParams{
+		ID: feedly.NewString(tagID),
+	})
+	require.NoError(t, err)
+
+	_, err = client.Tags.TagEntries([]feedly.StreamID{feedly.StreamID(tagID), feedly.StreamID(savedID)}, entryIDs)
+	require.NoError(t, err)
+
+	entryTagsResponse, _, err := client.Tags.EntryTags(entryIDs[0])
+	require.NoError(t, err)
+	require.Len(t, entryTagsResponse.Tags, 2)
+	assert.Equal(t, tagID, *entryTagsResponse.Tags[1].ID)
+
+	_, err = client.Tags.UntagEntries([]feedly.StreamID{feedly.StreamID(tagID)}, entryIDs[:1])
+	require.NoError(t, err)
+
+	entryTagsResponse, _, err = client.Tags.EntryTags(entryIDs[0])
+	require.NoError(t, err)
+	require.Len(t, entryTagsResponse.Tags, 1)
+	assert.Equal(t, savedID, *entryTagsResponse.Tags[0].ID)
+
+	_, err = client.Tags.Rename(feedly.StreamID(tagID), "reading")
+	require.NoError(t, err)
+
+	listResponse, _, err := client.Tags.List()
+	require.NoError(t, err)
+	require.Len(t, listResponse.Tags, 3)
+	assert.Equal(t, savedID, *listResponse.Tags[1].ID)
+	assert.Equal(t, "reading", *listResponse.Tags[2].Label)
+
+	_, err = client.Tags.Delete([]feedly.StreamID{feedly.StreamID(tagID)})
+	require.NoError(t, err)
+
+	listResponse, _, err = client.Tags.List()
+	require.NoError(t, err)
+	assert.Len(t, listResponse.Tags, 2)
+
+	entryTagsResponse, _, err = client.Tags.EntryTags(entryIDs[1])
+	require.NoError(t, err)
+	assert.Len(t, entryTagsResponse.Tags, 1)
+}
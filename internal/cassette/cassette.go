@@ -0,0 +1,321 @@
+// Package cassette records HTTP interactions to a file and replays them, so a test suite written against the live
+// Feedly API can run offline and deterministically.
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// redacted replaces scrubbed secrets.
+const redacted = "REDACTED"
+
+// scrubbedHeaders are the headers never persisted to a cassette.
+var scrubbedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// tokenPattern matches OAuth2 tokens and client secrets embedded in JSON bodies.
+var tokenPattern = regexp.MustCompile(`"(access_token|refresh_token|id_token|client_secret|accessToken|refreshToken|clientSecret)"\s*:\s*"[^"]*"`)
+
+// scrubbedFormFields are the fields of form-encoded bodies, as sent to the OAuth2 token endpoint, never persisted to a
+// cassette.
+var scrubbedFormFields = []string{"access_token", "refresh_token", "id_token", "client_secret", "code", "password"}
+
+// Cassette is a recorded sequence of HTTP interactions.
+type Cassette struct {
+	// Seed is the random seed used while recording, so a replaying test can reproduce its random choices.
+	Seed int64 `json:"seed"`
+	// Now is the time at which the recording started, so a replaying test can reproduce time dependent values.
+	Now time.Time `json:"now"`
+	// Metadata holds any other value a replaying test needs to reproduce the recording.
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	Interactions []Interaction     `json:"interactions"`
+
+	mu   sync.Mutex
+	used []bool
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode   int         `json:"statusCode"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty"`
+}
+
+// New returns an empty Cassette.
+func New(seed int64, now time.Time) *Cassette {
+	return &Cassette{
+		Seed:     seed,
+		Now:      now,
+		Metadata: make(map[string]string),
+	}
+}
+
+// Load reads a Cassette from the file filename.
+func Load(filename string) (*Cassette, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cassette{}
+
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("cassette: %s: %v", filename, err)
+	}
+
+	c.used = make([]bool, len(c.Interactions))
+
+	return c, nil
+}
+
+// Save writes the Cassette to the file filename, creating its directory if needed.
+func (c *Cassette) Save(filename string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, b, 0644)
+}
+
+// Recorder is an http.RoundTripper that sends requests through Transport and records the interactions, with
+// credentials scrubbed, to Cassette.
+type Recorder struct {
+	Cassette  *Cassette
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readBody(req.GetBody)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: scrubHeader(req.Header),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     scrubHeader(resp.Header),
+		},
+	}
+
+	if !isMultipart(req.Header) {
+		interaction.Request.Body, interaction.Request.BodyEncoding = encodeBody(requestBody, req.Header)
+	}
+
+	interaction.Response.Body, interaction.Response.BodyEncoding = encodeBody(responseBody, resp.Header)
+
+	r.Cassette.mu.Lock()
+	r.Cassette.Interactions = append(r.Cassette.Interactions, interaction)
+	r.Cassette.mu.Unlock()
+
+	return resp, nil
+}
+
+// Replayer is an http.RoundTripper answering requests with the interactions recorded in Cassette, without any network
+// access. Each recorded interaction is replayed at most once.
+type Replayer struct {
+	Cassette *Cassette
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readBody(req.GetBody)
+	if err != nil {
+		return nil, err
+	}
+
+	body, _ := encodeBody(requestBody, req.Header)
+	if isMultipart(req.Header) {
+		body = ""
+	}
+
+	interaction, ok := r.Cassette.take(req.Method, req.URL.String(), body)
+	if !ok {
+		return nil, fmt.Errorf("cassette: no recorded interaction for %s %s", req.Method, req.URL)
+	}
+
+	responseBody, err := decodeBody(interaction.Response.Body, interaction.Response.BodyEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	header := interaction.Response.Header
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       req,
+	}, nil
+}
+
+// take returns the first unused interaction whose request has the same method, URL, and body. A request that was not
+// recorded is never answered with the response of another one.
+func (c *Cassette) take(method string, rawURL string, body string) (Interaction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.used) != len(c.Interactions) {
+		c.used = make([]bool, len(c.Interactions))
+	}
+
+	for i, interaction := range c.Interactions {
+		if c.used[i] || interaction.Request.Method != method || interaction.Request.URL != rawURL || interaction.Request.Body != body {
+			continue
+		}
+
+		c.used[i] = true
+
+		return interaction, true
+	}
+
+	return Interaction{}, false
+}
+
+// readBody returns a copy of a request body obtained with getBody.
+func readBody(getBody func() (io.ReadCloser, error)) ([]byte, error) {
+	if getBody == nil {
+		return nil, nil
+	}
+
+	body, err := getBody()
+	if err != nil {
+		return nil, err
+	}
+
+	defer body.Close()
+
+	return ioutil.ReadAll(body)
+}
+
+// encodeBody returns b, sent with header, as a string along with its encoding, scrubbing any embedded token or secret.
+func encodeBody(b []byte, header http.Header) (string, string) {
+	if len(b) == 0 {
+		return "", ""
+	}
+
+	if !utf8.Valid(b) {
+		return base64.StdEncoding.EncodeToString(b), "base64"
+	}
+
+	if isForm(header) {
+		return scrubForm(string(b)), ""
+	}
+
+	return tokenPattern.ReplaceAllString(string(b), `"$1":"`+redacted+`"`), ""
+}
+
+// scrubForm returns the form-encoded body s with the values of its secret fields redacted. A body that cannot be
+// parsed is redacted entirely rather than risk persisting a secret.
+func scrubForm(s string) string {
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		return redacted
+	}
+
+	for _, key := range scrubbedFormFields {
+		if _, ok := values[key]; ok {
+			values.Set(key, redacted)
+		}
+	}
+
+	return values.Encode()
+}
+
+// decodeBody reverses encodeBody.
+func decodeBody(s string, encoding string) ([]byte, error) {
+	if encoding == "base64" {
+		return base64.StdEncoding.DecodeString(s)
+	}
+
+	return []byte(s), nil
+}
+
+// scrubHeader returns a copy of header without credentials.
+func scrubHeader(header http.Header) http.Header {
+	scrubbed := header.Clone()
+
+	for _, key := range scrubbedHeaders {
+		scrubbed.Del(key)
+	}
+
+	return scrubbed
+}
+
+// isForm reports whether header describes a form-encoded body.
+func isForm(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+
+	return err == nil && mediaType == "application/x-www-form-urlencoded"
+}
+
+// isMultipart reports whether header describes a multipart body, whose random boundary makes it unsuitable for
+// matching.
+func isMultipart(header http.Header) bool {
+	return strings.HasPrefix(header.Get("Content-Type"), "multipart/")
+}
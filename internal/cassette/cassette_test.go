@@ -0,0 +1,164 @@
+package cassette_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sfanous/go-feedly/internal/cassette"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+
+		w.Header().Set("Set-Cookie", "session=secret")
+		_, _ = w.Write([]byte(`{"access_token":"secret","echo":"` + r.Method + " " + string(b) + `"}`))
+	}))
+	defer server.Close()
+
+	recording := cassette.New(42, time.Unix(1600000000, 0).UTC())
+	recording.Metadata["feeds"] = "8"
+
+	httpClient := &http.Client{
+		Transport: &cassette.Recorder{
+			Cassette:  recording,
+			Transport: http.DefaultTransport,
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v3/markers", strings.NewReader("body"))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+
+	b, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"access_token":"secret","echo":"POST body"}`, string(b))
+
+	dir, err := ioutil.TempDir("", "cassette")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "cassettes", "test.json")
+	require.NoError(t, recording.Save(filename))
+
+	replaying, err := cassette.Load(filename)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), replaying.Seed)
+	assert.True(t, recording.Now.Equal(replaying.Now))
+	assert.Equal(t, "8", replaying.Metadata["feeds"])
+	require.Len(t, replaying.Interactions, 1)
+	assert.Empty(t, replaying.Interactions[0].Request.Header.Get("Authorization"))
+	assert.Empty(t, replaying.Interactions[0].Response.Header.Get("Set-Cookie"))
+
+	httpClient = &http.Client{
+		Transport: &cassette.Replayer{
+			Cassette: replaying,
+		},
+	}
+
+	resp, err = httpClient.Post(server.URL+"/v3/markers", "text/plain", strings.NewReader("body"))
+	require.NoError(t, err)
+
+	b, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"access_token":"REDACTED","echo":"POST body"}`, string(b))
+
+	_, err = httpClient.Post(server.URL+"/v3/markers", "text/plain", strings.NewReader("body"))
+	assert.Error(t, err)
+
+	recording.Interactions = append(recording.Interactions, recording.Interactions[0])
+	require.NoError(t, recording.Save(filename))
+
+	replaying, err = cassette.Load(filename)
+	require.NoError(t, err)
+
+	httpClient = &http.Client{
+		Transport: &cassette.Replayer{
+			Cassette: replaying,
+		},
+	}
+
+	for _, req := range []struct {
+		url  string
+		body string
+	}{
+		{server.URL + "/v3/markers?count=1", "body"},
+		{server.URL + "/v3/markers", "other body"},
+	} {
+		_, err = httpClient.Post(req.url, "text/plain", strings.NewReader(req.body))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no recorded interaction")
+	}
+}
+
+func TestRecordAndReplayForm(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-www-form-urlencoded")
+		_, _ = w.Write([]byte("access_token=hunter2&token_type=Bearer"))
+	}))
+	defer server.Close()
+
+	recording := cassette.New(42, time.Unix(1600000000, 0).UTC())
+
+	httpClient := &http.Client{
+		Transport: &cassette.Recorder{
+			Cassette:  recording,
+			Transport: http.DefaultTransport,
+		},
+	}
+
+	form := url.Values{
+		"client_id":     {"feedly"},
+		"client_secret": {"hunter2"},
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {"hunter2"},
+	}
+
+	resp, err := httpClient.PostForm(server.URL+"/v3/auth/token", form)
+	require.NoError(t, err)
+
+	b, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "access_token=hunter2&token_type=Bearer", string(b))
+
+	dir, err := ioutil.TempDir("", "cassette")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "test.json")
+	require.NoError(t, recording.Save(filename))
+
+	b, err = ioutil.ReadFile(filename)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "hunter2")
+
+	replaying, err := cassette.Load(filename)
+	require.NoError(t, err)
+	require.Len(t, replaying.Interactions, 1)
+	assert.Equal(t, "client_id=feedly&client_secret=REDACTED&grant_type=refresh_token&refresh_token=REDACTED", replaying.Interactions[0].Request.Body)
+	assert.Equal(t, "access_token=REDACTED&token_type=Bearer", replaying.Interactions[0].Response.Body)
+
+	httpClient = &http.Client{
+		Transport: &cassette.Replayer{
+			Cassette: replaying,
+		},
+	}
+
+	// The replayed request carries the real secrets, which are scrubbed the same way before matching.
+	_, err = httpClient.PostForm(server.URL+"/v3/auth/token", form)
+	require.NoError(t, err)
+}
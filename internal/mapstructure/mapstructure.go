@@ -2,7 +2,6 @@ package mapstructure
 
 import (
 	"reflect"
-	"time"
 
 	"github.com/mitchellh/mapstructure"
 	. "github.com/sfanous/go-feedly/pkg/time"
@@ -25,10 +24,17 @@ func Decode(input interface{}, result interface{}) error {
 	return nil
 }
 
+var timeType = reflect.TypeOf(Time{})
+
 func decodeHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
-	if to.Kind() == reflect.Struct && to.Name() == "Time" {
+	if to == timeType {
+		t, _, err := Parse(data)
+		if err != nil {
+			return nil, err
+		}
+
 		return &Time{
-			Time: time.Unix(int64(data.(float64))/1000, 0),
+			Time: t,
 		}, nil
 	}
 
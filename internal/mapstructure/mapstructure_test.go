@@ -0,0 +1,26 @@
+package mapstructure
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/sfanous/go-feedly/pkg/time"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeTime(t *testing.T) {
+	expected := time.Unix(1600000000, 123*int64(time.Millisecond))
+
+	for _, input := range []interface{}{float64(1600000000123), "1600000000123", 1600000000123.0} {
+		decoded := struct {
+			Created *Time
+			Updated *Time
+		}{}
+
+		require.NoError(t, Decode(map[string]interface{}{"created": input, "updated": nil}, &decoded))
+		require.NotNil(t, decoded.Created)
+		assert.True(t, expected.Equal(decoded.Created.Time), decoded.Created.Time)
+		assert.Nil(t, decoded.Updated)
+	}
+}
@@ -1,11 +1,21 @@
 package decoders
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"io/ioutil"
 	"net/http"
 )
 
+// JSONDecoder decodes the HTTP response into a JSON-tagged struct value.
+type JSONDecoder struct {
+}
+
+// Decode implements the decoders.ResponseDecoder interface.
+func (d JSONDecoder) Decode(resp *http.Response, v interface{}) error {
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
 // StringDecoder decodes the HTTP response into a string.
 type StringDecoder struct {
 }
@@ -3,7 +3,10 @@ package time
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,32 +15,105 @@ type Time struct {
 	time.Time
 }
 
+// FromUnixMilli returns the local time corresponding to the given Unix time in milliseconds.
+func FromUnixMilli(msec int64) time.Time {
+	return time.Unix(msec/1000, (msec%1000)*int64(time.Millisecond))
+}
+
+// UnixMilli returns t as a Unix time in milliseconds, the representation used by Feedly.
+func UnixMilli(t time.Time) int64 {
+	return t.Unix()*1000 + int64(t.Nanosecond())/int64(time.Millisecond)
+}
+
+// Parse returns the time represented by v, a Unix time in milliseconds encoded as a JSON number, a float, or a string.
+// RFC 3339 strings are accepted as well. The boolean result is false if v is nil or an empty string.
+func Parse(v interface{}) (time.Time, bool, error) {
+	switch v := v.(type) {
+	case nil:
+		return time.Time{}, false, nil
+	case float32:
+		return fromFloatMilli(float64(v)), true, nil
+	case float64:
+		return fromFloatMilli(v), true, nil
+	case int:
+		return FromUnixMilli(int64(v)), true, nil
+	case int64:
+		return FromUnixMilli(v), true, nil
+	case uint64:
+		return FromUnixMilli(int64(v)), true, nil
+	case json.Number:
+		return parseString(v.String())
+	case string:
+		return parseString(v)
+	default:
+		return time.Time{}, false, fmt.Errorf("time: cannot parse %T as a timestamp", v)
+	}
+}
+
+func parseString(s string) (time.Time, bool, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "null" {
+		return time.Time{}, false, nil
+	}
+
+	if msec, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return FromUnixMilli(msec), true, nil
+	}
+
+	if msec, err := strconv.ParseFloat(s, 64); err == nil {
+		return fromFloatMilli(msec), true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("time: cannot parse %q as a timestamp", s)
+	}
+
+	return t, true, nil
+}
+
+// fromFloatMilli returns the local time corresponding to the given fractional Unix time in milliseconds.
+func fromFloatMilli(msec float64) time.Time {
+	whole := math.Floor(msec)
+
+	return FromUnixMilli(int64(whole)).Add(time.Duration(math.Round((msec - whole) * float64(time.Millisecond))))
+}
+
 // EncodeValues implements the query.Encoder interface.
 func (t *Time) EncodeValues(key string, v *url.Values) error {
-	v.Set(key, fmt.Sprintf("%d", t.Time.Unix()*1000))
+	v.Set(key, strconv.FormatInt(UnixMilli(t.Time), 10))
 
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaler interface.
+// MarshalJSON implements the json.Marshaler interface. The zero Time is encoded as null.
 func (t *Time) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.Time.Unix() * 1000)
+	if t.Time.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(UnixMilli(t.Time))
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (t *Time) UnmarshalJSON(b []byte) error {
-	temp := struct {
-		Updated *int64 `json:"updated,omitempty"`
-	}{}
+	var v interface{}
 
-	err := json.Unmarshal(b, &temp.Updated)
+	decoder := json.NewDecoder(strings.NewReader(string(b)))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&v); err != nil {
+		return err
+	}
+
+	parsed, ok, err := Parse(v)
 	if err != nil {
 		return err
 	}
 
-	if temp.Updated != nil {
-		t.Time = time.Unix(*temp.Updated/1000, 0)
+	if ok {
+		t.Time = parsed
 	}
 
-	return err
+	return nil
 }
@@ -0,0 +1,64 @@
+package time_test
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	. "github.com/sfanous/go-feedly/pkg/time"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalJSON(t *testing.T) {
+	expected := time.Unix(1600000000, 123*int64(time.Millisecond))
+
+	testCases := []struct {
+		name  string
+		input string
+	}{
+		{name: "Number", input: `1600000000123`},
+		{name: "String", input: `"1600000000123"`},
+		{name: "Float", input: `1600000000123.0`},
+		{name: "RFC3339", input: `"` + expected.UTC().Format(time.RFC3339Nano) + `"`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var decoded Time
+
+			require.NoError(t, json.Unmarshal([]byte(testCase.input), &decoded))
+			assert.True(t, expected.Equal(decoded.Time), decoded.Time)
+		})
+	}
+
+	var decoded Time
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), &decoded))
+	assert.True(t, decoded.IsZero())
+
+	assert.Error(t, json.Unmarshal([]byte(`"yesterday"`), &decoded))
+}
+
+func TestRoundTrip(t *testing.T) {
+	original := &Time{Time: time.Unix(1600000000, 999*int64(time.Millisecond))}
+
+	b, err := json.Marshal(original)
+	require.NoError(t, err)
+	assert.Equal(t, `1600000000999`, string(b))
+
+	decoded := &Time{}
+
+	require.NoError(t, json.Unmarshal(b, decoded))
+	assert.True(t, original.Equal(decoded.Time))
+
+	v := url.Values{}
+
+	require.NoError(t, original.EncodeValues("newerThan", &v))
+	assert.Equal(t, "1600000000999", v.Get("newerThan"))
+
+	b, err = json.Marshal(&Time{})
+	require.NoError(t, err)
+	assert.Equal(t, `null`, string(b))
+}